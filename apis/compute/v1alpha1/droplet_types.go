@@ -46,9 +46,21 @@ type DropletParameters struct {
 
 	// Image: The image ID of a public or private image, or the unique slug
 	// identifier for a public image. This image will be the base image for
-	// your Droplet.
+	// your Droplet. The ID of a DropletSnapshot can be used as the image.
+	// +optional
 	// +immutable
-	Image string `json:"image"`
+	// +crossplane:generate:reference:type=DropletSnapshot
+	Image string `json:"image,omitempty"`
+
+	// ImageRef: A reference to the DropletSnapshot used as the image of the
+	// Droplet.
+	// +optional
+	ImageRef *xpv1.Reference `json:"imageRef,omitempty"`
+
+	// ImageSelector: Selects the DropletSnapshot used as the image of the
+	// Droplet.
+	// +optional
+	ImageSelector *xpv1.Selector `json:"imageSelector,omitempty"`
 
 	// SSHKeys: An array containing the IDs or fingerprints of the SSH keys
 	// that you wish to embed in the Droplet's root account upon creation.
@@ -104,8 +116,18 @@ type DropletParameters struct {
 	// will be assigned to your account's default VPC for the region.
 	// +optional
 	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-digitalocean/apis/vpc/v1alpha1.VPC
 	VPCUUID *string `json:"vpcUuid,omitempty"`
 
+	// VPCUUIDRef: A reference to the VPC to which the Droplet will be
+	// assigned.
+	// +optional
+	VPCUUIDRef *xpv1.Reference `json:"vpcUuidRef,omitempty"`
+
+	// VPCUUIDSelector: Selects the VPC to which the Droplet will be assigned.
+	// +optional
+	VPCUUIDSelector *xpv1.Selector `json:"vpcUuidSelector,omitempty"`
+
 	// WithDropletAgent: A boolean indicating whether to install the DigitalOcean
 	// agent used for providing access to the Droplet web console in the control panel.
 	// To prevent it from being installed, set to false.
@@ -129,6 +151,9 @@ type DropletObservation struct {
 	// Public IPv4 address of the resource.
 	PublicIPv4 string `json:"publicIPv4,omitempty"`
 
+	// Public IPv6 address of the resource.
+	PublicIPv6 string `json:"publicIPv6,omitempty"`
+
 	// Resource region slug.
 	Region string `json:"region,omitempty"`
 
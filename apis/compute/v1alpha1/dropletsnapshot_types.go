@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DropletSnapshotParameters define the desired state of a DigitalOcean
+// Droplet snapshot.
+type DropletSnapshotParameters struct {
+	// DropletID: The unique identifier of the Droplet to snapshot. The
+	// Droplet is powered off while the snapshot is taken unless it is
+	// already off.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=Droplet
+	// +crossplane:generate:reference:extractor=DropletID()
+	DropletID *string `json:"dropletId,omitempty"`
+
+	// DropletIDRef: A reference to the Droplet to snapshot.
+	// +optional
+	DropletIDRef *xpv1.Reference `json:"dropletIdRef,omitempty"`
+
+	// DropletIDSelector: Selects the Droplet to snapshot.
+	// +optional
+	DropletIDSelector *xpv1.Selector `json:"dropletIdSelector,omitempty"`
+}
+
+// A DropletSnapshotSpec defines the desired state of a DropletSnapshot.
+type DropletSnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DropletSnapshotParameters `json:"forProvider"`
+}
+
+// A DropletSnapshotStatus represents the observed state of a DropletSnapshot.
+type DropletSnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DropletSnapshot is a managed resource that represents a snapshot of a
+// DigitalOcean Droplet. Its external name is the ID of the snapshot, which
+// can be used as the image of a new Droplet.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="SIZE",type="string",JSONPath=".status.atProvider.sizeGigabytes"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type DropletSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DropletSnapshotSpec   `json:"spec"`
+	Status DropletSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DropletSnapshotList contains a list of DropletSnapshot.
+type DropletSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DropletSnapshot `json:"items"`
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Firewall statuses.
+const (
+	FirewallStatusWaiting   = "waiting"
+	FirewallStatusSucceeded = "succeeded"
+	FirewallStatusFailed    = "failed"
+)
+
+// FirewallParameters define the desired state of a DigitalOcean Cloud
+// Firewall. Most fields map directly to a Firewall:
+// https://docs.digitalocean.com/reference/api/api-reference/#tag/Firewalls
+type FirewallParameters struct {
+	// InboundRules: The rules specifying which inbound traffic is allowed.
+	// +optional
+	InboundRules []FirewallInboundRule `json:"inboundRules,omitempty"`
+
+	// OutboundRules: The rules specifying which outbound traffic is allowed.
+	// +optional
+	OutboundRules []FirewallOutboundRule `json:"outboundRules,omitempty"`
+
+	// DropletIDs: The IDs of the Droplets the Firewall is assigned to.
+	// +optional
+	// +crossplane:generate:reference:type=Droplet
+	// +crossplane:generate:reference:extractor=DropletID()
+	// +crossplane:generate:reference:refFieldName=DropletIDRefs
+	// +crossplane:generate:reference:selectorFieldName=DropletIDSelector
+	DropletIDs []string `json:"dropletIds,omitempty"`
+
+	// DropletIDRefs: References to the Droplets the Firewall is assigned to.
+	// +optional
+	DropletIDRefs []xpv1.Reference `json:"dropletIdRefs,omitempty"`
+
+	// DropletIDSelector: Selects the Droplets the Firewall is assigned to.
+	// +optional
+	DropletIDSelector *xpv1.Selector `json:"dropletIdSelector,omitempty"`
+
+	// Tags: The names of the tags of the Droplets the Firewall is assigned to.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+// A FirewallInboundRule allows inbound traffic from the supplied sources.
+type FirewallInboundRule struct {
+	// Protocol: The type of traffic allowed.
+	// +kubebuilder:validation:Enum="tcp";"udp";"icmp"
+	Protocol string `json:"protocol"`
+
+	// PortRange: The ports on which traffic is allowed, either a single port,
+	// a range like "8000-9000", or "all". It is omitted for icmp.
+	// +optional
+	PortRange *string `json:"portRange,omitempty"`
+
+	// Sources: The sources from which traffic is allowed.
+	Sources FirewallTargets `json:"sources"`
+}
+
+// A FirewallOutboundRule allows outbound traffic to the supplied
+// destinations.
+type FirewallOutboundRule struct {
+	// Protocol: The type of traffic allowed.
+	// +kubebuilder:validation:Enum="tcp";"udp";"icmp"
+	Protocol string `json:"protocol"`
+
+	// PortRange: The ports on which traffic is allowed, either a single port,
+	// a range like "8000-9000", or "all". It is omitted for icmp.
+	// +optional
+	PortRange *string `json:"portRange,omitempty"`
+
+	// Destinations: The destinations to which traffic is allowed.
+	Destinations FirewallTargets `json:"destinations"`
+}
+
+// FirewallTargets are the sources or destinations of the traffic allowed by a
+// Firewall rule.
+type FirewallTargets struct {
+	// Addresses: IPv4 addresses, IPv6 addresses, or CIDR ranges.
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+
+	// DropletIDs: The IDs of Droplets.
+	// +optional
+	// +crossplane:generate:reference:type=Droplet
+	// +crossplane:generate:reference:extractor=DropletID()
+	// +crossplane:generate:reference:refFieldName=DropletIDRefs
+	// +crossplane:generate:reference:selectorFieldName=DropletIDSelector
+	DropletIDs []string `json:"dropletIds,omitempty"`
+
+	// DropletIDRefs: References to Droplets.
+	// +optional
+	DropletIDRefs []xpv1.Reference `json:"dropletIdRefs,omitempty"`
+
+	// DropletIDSelector: Selects Droplets.
+	// +optional
+	DropletIDSelector *xpv1.Selector `json:"dropletIdSelector,omitempty"`
+
+	// Tags: The names of the tags of Droplets.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// LoadBalancerUIDs: The IDs of load balancers.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-digitalocean/apis/loadbalancer/v1alpha1.LB
+	// +crossplane:generate:reference:refFieldName=LoadBalancerUIDRefs
+	// +crossplane:generate:reference:selectorFieldName=LoadBalancerUIDSelector
+	LoadBalancerUIDs []string `json:"loadBalancerUids,omitempty"`
+
+	// LoadBalancerUIDRefs: References to load balancers.
+	// +optional
+	LoadBalancerUIDRefs []xpv1.Reference `json:"loadBalancerUidRefs,omitempty"`
+
+	// LoadBalancerUIDSelector: Selects load balancers.
+	// +optional
+	LoadBalancerUIDSelector *xpv1.Selector `json:"loadBalancerUidSelector,omitempty"`
+
+	// KubernetesIDs: The IDs of Kubernetes clusters.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-digitalocean/apis/kubernetes/v1alpha1.DOKubernetesCluster
+	// +crossplane:generate:reference:refFieldName=KubernetesIDRefs
+	// +crossplane:generate:reference:selectorFieldName=KubernetesIDSelector
+	KubernetesIDs []string `json:"kubernetesIds,omitempty"`
+
+	// KubernetesIDRefs: References to Kubernetes clusters.
+	// +optional
+	KubernetesIDRefs []xpv1.Reference `json:"kubernetesIdRefs,omitempty"`
+
+	// KubernetesIDSelector: Selects Kubernetes clusters.
+	// +optional
+	KubernetesIDSelector *xpv1.Selector `json:"kubernetesIdSelector,omitempty"`
+}
+
+// A FirewallObservation reflects the observed state of a Firewall on
+// DigitalOcean.
+type FirewallObservation struct {
+	// ID for the resource. This identifier is defined by the server.
+	ID string `json:"id,omitempty"`
+
+	// Name of the Firewall.
+	Name string `json:"name,omitempty"`
+
+	// A Status string indicating whether the changes of the Firewall have
+	// been applied.
+	//
+	// Possible values:
+	//   "waiting"
+	//   "succeeded"
+	//   "failed"
+	Status string `json:"status,omitempty"`
+
+	// CreatedAt in RFC3339 text format.
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	// IDs of the Droplets the Firewall is assigned to.
+	DropletIDs []int `json:"dropletIds,omitempty"`
+
+	// Tags of the Droplets the Firewall is assigned to.
+	Tags []string `json:"tags,omitempty"`
+
+	// Changes of the Firewall that are not yet applied to its Droplets.
+	PendingChanges []FirewallPendingChange `json:"pendingChanges,omitempty"`
+}
+
+// A FirewallPendingChange reflects a change of a Firewall that is not yet
+// applied to one of its Droplets.
+type FirewallPendingChange struct {
+	// ID of the Droplet the change applies to.
+	DropletID int `json:"dropletId"`
+
+	// Whether the Droplet is removed from the Firewall.
+	Removing bool `json:"removing"`
+
+	// Status of the change.
+	Status string `json:"status"`
+}
+
+// A FirewallSpec defines the desired state of a Firewall.
+type FirewallSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FirewallParameters `json:"forProvider"`
+}
+
+// A FirewallStatus represents the observed state of a Firewall.
+type FirewallStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FirewallObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Firewall is a managed resource that represents a DigitalOcean Cloud
+// Firewall.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type Firewall struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FirewallSpec   `json:"spec"`
+	Status FirewallStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FirewallList contains a list of Firewall.
+type FirewallList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Firewall `json:"items"`
+}
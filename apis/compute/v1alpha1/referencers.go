@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strconv"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// DropletID extracts the ID of a Droplet, which is only known once the Droplet
+// was created, from its status.
+func DropletID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		d, ok := mg.(*Droplet)
+		if !ok || d.Status.AtProvider.ID == 0 {
+			return ""
+		}
+		return strconv.Itoa(d.Status.AtProvider.ID)
+	}
+}
+
+// DropletPublicIPv4 extracts the public IPv4 address of a Droplet from its
+// status.
+func DropletPublicIPv4() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		d, ok := mg.(*Droplet)
+		if !ok {
+			return ""
+		}
+		return d.Status.AtProvider.PublicIPv4
+	}
+}
+
+// DropletPublicIPv6 extracts the public IPv6 address of a Droplet from its
+// status. It is empty unless IPv6 is enabled for the Droplet.
+func DropletPublicIPv6() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		d, ok := mg.(*Droplet)
+		if !ok {
+			return ""
+		}
+		return d.Status.AtProvider.PublicIPv6
+	}
+}
@@ -45,6 +45,60 @@ var (
 	DropletGroupVersionKind = SchemeGroupVersion.WithKind(DropletKind)
 )
 
+// Volume type metadata.
+var (
+	VolumeKind             = reflect.TypeOf(Volume{}).Name()
+	VolumeGroupKind        = schema.GroupKind{Group: Group, Kind: VolumeKind}.String()
+	VolumeKindAPIVersion   = VolumeKind + "." + SchemeGroupVersion.String()
+	VolumeGroupVersionKind = SchemeGroupVersion.WithKind(VolumeKind)
+)
+
+// VolumeAttachment type metadata.
+var (
+	VolumeAttachmentKind             = reflect.TypeOf(VolumeAttachment{}).Name()
+	VolumeAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: VolumeAttachmentKind}.String()
+	VolumeAttachmentKindAPIVersion   = VolumeAttachmentKind + "." + SchemeGroupVersion.String()
+	VolumeAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(VolumeAttachmentKind)
+)
+
+// DropletSnapshot type metadata.
+var (
+	DropletSnapshotKind             = reflect.TypeOf(DropletSnapshot{}).Name()
+	DropletSnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: DropletSnapshotKind}.String()
+	DropletSnapshotKindAPIVersion   = DropletSnapshotKind + "." + SchemeGroupVersion.String()
+	DropletSnapshotGroupVersionKind = SchemeGroupVersion.WithKind(DropletSnapshotKind)
+)
+
+// VolumeSnapshot type metadata.
+var (
+	VolumeSnapshotKind             = reflect.TypeOf(VolumeSnapshot{}).Name()
+	VolumeSnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: VolumeSnapshotKind}.String()
+	VolumeSnapshotKindAPIVersion   = VolumeSnapshotKind + "." + SchemeGroupVersion.String()
+	VolumeSnapshotGroupVersionKind = SchemeGroupVersion.WithKind(VolumeSnapshotKind)
+)
+
+// SnapshotSchedule type metadata.
+var (
+	SnapshotScheduleKind             = reflect.TypeOf(SnapshotSchedule{}).Name()
+	SnapshotScheduleGroupKind        = schema.GroupKind{Group: Group, Kind: SnapshotScheduleKind}.String()
+	SnapshotScheduleKindAPIVersion   = SnapshotScheduleKind + "." + SchemeGroupVersion.String()
+	SnapshotScheduleGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotScheduleKind)
+)
+
+// Firewall type metadata.
+var (
+	FirewallKind             = reflect.TypeOf(Firewall{}).Name()
+	FirewallGroupKind        = schema.GroupKind{Group: Group, Kind: FirewallKind}.String()
+	FirewallKindAPIVersion   = FirewallKind + "." + SchemeGroupVersion.String()
+	FirewallGroupVersionKind = SchemeGroupVersion.WithKind(FirewallKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Droplet{}, &DropletList{})
+	SchemeBuilder.Register(&Volume{}, &VolumeList{})
+	SchemeBuilder.Register(&VolumeAttachment{}, &VolumeAttachmentList{})
+	SchemeBuilder.Register(&DropletSnapshot{}, &DropletSnapshotList{})
+	SchemeBuilder.Register(&VolumeSnapshot{}, &VolumeSnapshotList{})
+	SchemeBuilder.Register(&SnapshotSchedule{}, &SnapshotScheduleList{})
+	SchemeBuilder.Register(&Firewall{}, &FirewallList{})
 }
@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// A SnapshotObservation reflects the observed state of a snapshot on
+// DigitalOcean.
+type SnapshotObservation struct {
+	// ID for the snapshot. This identifier is defined by the server.
+	ID string `json:"id,omitempty"`
+
+	// Name of the snapshot.
+	Name string `json:"name,omitempty"`
+
+	// The billable size of the snapshot in GiB.
+	SizeGigabytes string `json:"sizeGigabytes,omitempty"`
+
+	// The minimum size in GiB of a Droplet or Volume created from the snapshot.
+	MinDiskSize int `json:"minDiskSize,omitempty"`
+
+	// Slugs of the regions the snapshot is available in.
+	Regions []string `json:"regions,omitempty"`
+
+	// CreatedAt in RFC3339 text format.
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	// Tags applied to the snapshot.
+	Tags []string `json:"tags,omitempty"`
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SnapshotScheduleParameters define the desired state of a schedule that
+// takes snapshots of DigitalOcean Droplets.
+type SnapshotScheduleParameters struct {
+	// Schedule: A cron expression in UTC specifying when snapshots are taken,
+	// e.g. "0 3 * * *".
+	Schedule string `json:"schedule"`
+
+	// DropletSelector: Selects the Droplets of which snapshots are taken.
+	DropletSelector SnapshotScheduleDropletSelector `json:"dropletSelector"`
+
+	// Retention: The policy deciding which snapshots taken by the schedule are
+	// deleted (Optional). Snapshots are kept forever when omitted.
+	// +optional
+	Retention *SnapshotRetentionPolicy `json:"retention,omitempty"`
+}
+
+// A SnapshotScheduleDropletSelector selects Droplets by the labels of their
+// Droplet managed resources or by their tags on DigitalOcean. Droplets
+// matching either are selected.
+type SnapshotScheduleDropletSelector struct {
+	// MatchLabels: Selects the Droplet managed resources with these labels.
+	// +optional
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+
+	// MatchTags: Selects the Droplets with any of these tags.
+	// +optional
+	MatchTags []string `json:"matchTags,omitempty"`
+}
+
+// A SnapshotRetentionPolicy defines which snapshots taken by a schedule are
+// deleted. A snapshot is deleted when it is not among the most recent
+// snapshots to keep of its Droplet and, if a maximum age is set, is older
+// than the maximum age.
+type SnapshotRetentionPolicy struct {
+	// KeepLast: The number of most recent snapshots of each Droplet to keep.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	KeepLast *int `json:"keepLast,omitempty"`
+
+	// MaxAgeDays: The number of days after which snapshots are deleted.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxAgeDays *int `json:"maxAgeDays,omitempty"`
+}
+
+// A SnapshotScheduleObservation reflects the observed state of a
+// SnapshotSchedule.
+type SnapshotScheduleObservation struct {
+	// The time at which snapshots were most recently taken.
+	// +kubebuilder:validation:Optional
+	LastRunAt *metav1.Time `json:"lastRunAt,omitempty"`
+
+	// The time at which snapshots are taken next.
+	// +kubebuilder:validation:Optional
+	NextRunAt *metav1.Time `json:"nextRunAt,omitempty"`
+
+	// The IDs of the Droplets selected by the most recent run.
+	// +kubebuilder:validation:Optional
+	DropletIDs []int `json:"dropletIds,omitempty"`
+
+	// The snapshots or deletions that failed during the most recent run.
+	// +kubebuilder:validation:Optional
+	Failures []SnapshotScheduleFailure `json:"failures,omitempty"`
+}
+
+// A SnapshotScheduleFailure reflects a snapshot or deletion of a snapshot
+// that failed.
+type SnapshotScheduleFailure struct {
+	// The ID of the Droplet of the snapshot.
+	DropletID int `json:"dropletId"`
+
+	// A message describing the failure.
+	Message string `json:"message"`
+}
+
+// A SnapshotScheduleSpec defines the desired state of a SnapshotSchedule.
+type SnapshotScheduleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SnapshotScheduleParameters `json:"forProvider"`
+}
+
+// A SnapshotScheduleStatus represents the observed state of a SnapshotSchedule.
+type SnapshotScheduleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SnapshotScheduleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SnapshotSchedule is a managed resource that periodically takes snapshots
+// of DigitalOcean Droplets and deletes the snapshots it took according to a
+// retention policy. Snapshots are named after the schedule followed by the
+// time they were taken.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SCHEDULE",type="string",JSONPath=".spec.forProvider.schedule"
+// +kubebuilder:printcolumn:name="LAST RUN",type="date",JSONPath=".status.atProvider.lastRunAt"
+// +kubebuilder:printcolumn:name="NEXT RUN",type="string",JSONPath=".status.atProvider.nextRunAt"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type SnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotScheduleSpec   `json:"spec"`
+	Status SnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotScheduleList contains a list of SnapshotSchedule.
+type SnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SnapshotSchedule `json:"items"`
+}
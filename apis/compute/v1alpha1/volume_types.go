@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// VolumeParameters define the desired state of a DigitalOcean block storage
+// Volume. Most fields map directly to a Volume:
+// https://docs.digitalocean.com/reference/api/api-reference/#tag/Block-Storage
+type VolumeParameters struct {
+	// Region: The unique slug identifier for the region that the Volume is
+	// created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// SizeGigabytes: The size of the Volume in GiB. Volumes can only grow,
+	// growing the Volume resizes it without detaching it.
+	// +kubebuilder:validation:Minimum=1
+	SizeGigabytes int64 `json:"sizeGigabytes"`
+
+	// Description: An optional free-form text field to describe the Volume.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// SnapshotID: The unique identifier of the Volume snapshot from which
+	// the Volume is created.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=VolumeSnapshot
+	SnapshotID *string `json:"snapshotId,omitempty"`
+
+	// SnapshotIDRef: A reference to the VolumeSnapshot from which the Volume
+	// is created.
+	// +optional
+	SnapshotIDRef *xpv1.Reference `json:"snapshotIdRef,omitempty"`
+
+	// SnapshotIDSelector: Selects the VolumeSnapshot from which the Volume is
+	// created.
+	// +optional
+	SnapshotIDSelector *xpv1.Selector `json:"snapshotIdSelector,omitempty"`
+
+	// FilesystemType: The name of the filesystem type to be used on the
+	// Volume. The Volume is not formatted when omitted.
+	// +kubebuilder:validation:Enum="ext4";"xfs"
+	// +optional
+	// +immutable
+	FilesystemType *string `json:"filesystemType,omitempty"`
+
+	// FilesystemLabel: The label applied to the filesystem of the Volume.
+	// +optional
+	// +immutable
+	FilesystemLabel *string `json:"filesystemLabel,omitempty"`
+
+	// Tags: A flat array of tag names as strings to apply to the Volume after
+	// it is created. Tag names can either be existing or new tags.
+	// +optional
+	// +immutable
+	Tags []string `json:"tags,omitempty"`
+}
+
+// A VolumeObservation reflects the observed state of a Volume on DigitalOcean.
+type VolumeObservation struct {
+	// ID for the resource. This identifier is defined by the server.
+	ID string `json:"id,omitempty"`
+
+	// Name of the Volume.
+	Name string `json:"name,omitempty"`
+
+	// Resource region slug.
+	Region string `json:"region,omitempty"`
+
+	// Size of the Volume in GiB.
+	SizeGigabytes int64 `json:"sizeGigabytes,omitempty"`
+
+	// Description of the Volume.
+	Description string `json:"description,omitempty"`
+
+	// IDs of the Droplets the Volume is attached to.
+	DropletIDs []int `json:"dropletIds,omitempty"`
+
+	// CreatedAt in RFC3339 text format.
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	// Type of the filesystem of the Volume.
+	FilesystemType string `json:"filesystemType,omitempty"`
+
+	// Label of the filesystem of the Volume.
+	FilesystemLabel string `json:"filesystemLabel,omitempty"`
+
+	// Tags applied to the Volume.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// A VolumeSpec defines the desired state of a Volume.
+type VolumeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VolumeParameters `json:"forProvider"`
+}
+
+// A VolumeStatus represents the observed state of a Volume.
+type VolumeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VolumeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Volume is a managed resource that represents a DigitalOcean block storage
+// Volume.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".status.atProvider.region"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".status.atProvider.sizeGigabytes"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type Volume struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeSpec   `json:"spec"`
+	Status VolumeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VolumeList contains a list of Volume.
+type VolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Volume `json:"items"`
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// VolumeAttachmentParameters define the desired state of the attachment of a
+// DigitalOcean block storage Volume to a Droplet.
+type VolumeAttachmentParameters struct {
+	// VolumeID: The unique identifier of the Volume to attach.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=Volume
+	VolumeID *string `json:"volumeId,omitempty"`
+
+	// VolumeIDRef: A reference to the Volume to attach.
+	// +optional
+	VolumeIDRef *xpv1.Reference `json:"volumeIdRef,omitempty"`
+
+	// VolumeIDSelector: Selects the Volume to attach.
+	// +optional
+	VolumeIDSelector *xpv1.Selector `json:"volumeIdSelector,omitempty"`
+
+	// DropletID: The unique identifier of the Droplet the Volume is attached
+	// to. The Droplet must be in the region of the Volume.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=Droplet
+	// +crossplane:generate:reference:extractor=DropletID()
+	DropletID *string `json:"dropletId,omitempty"`
+
+	// DropletIDRef: A reference to the Droplet the Volume is attached to.
+	// +optional
+	DropletIDRef *xpv1.Reference `json:"dropletIdRef,omitempty"`
+
+	// DropletIDSelector: Selects the Droplet the Volume is attached to.
+	// +optional
+	DropletIDSelector *xpv1.Selector `json:"dropletIdSelector,omitempty"`
+}
+
+// A VolumeAttachmentObservation reflects the observed state of the attachment
+// of a Volume to a Droplet on DigitalOcean.
+type VolumeAttachmentObservation struct {
+	// VolumeName is the name of the attached Volume.
+	VolumeName string `json:"volumeName,omitempty"`
+
+	// DropletIDs are the IDs of the Droplets the Volume is attached to.
+	DropletIDs []int `json:"dropletIds,omitempty"`
+}
+
+// A VolumeAttachmentSpec defines the desired state of a VolumeAttachment.
+type VolumeAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VolumeAttachmentParameters `json:"forProvider"`
+}
+
+// A VolumeAttachmentStatus represents the observed state of a VolumeAttachment.
+type VolumeAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VolumeAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VolumeAttachment is a managed resource that represents the attachment of
+// a DigitalOcean block storage Volume to a Droplet.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VOLUME",type="string",JSONPath=".status.atProvider.volumeName"
+// +kubebuilder:printcolumn:name="DROPLET",type="string",JSONPath=".spec.forProvider.dropletId"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type VolumeAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeAttachmentSpec   `json:"spec"`
+	Status VolumeAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VolumeAttachmentList contains a list of VolumeAttachment.
+type VolumeAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeAttachment `json:"items"`
+}
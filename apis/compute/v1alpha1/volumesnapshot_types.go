@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// VolumeSnapshotParameters define the desired state of a DigitalOcean
+// Volume snapshot.
+type VolumeSnapshotParameters struct {
+	// VolumeID: The unique identifier of the Volume to snapshot.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=Volume
+	VolumeID *string `json:"volumeId,omitempty"`
+
+	// VolumeIDRef: A reference to the Volume to snapshot.
+	// +optional
+	VolumeIDRef *xpv1.Reference `json:"volumeIdRef,omitempty"`
+
+	// VolumeIDSelector: Selects the Volume to snapshot.
+	// +optional
+	VolumeIDSelector *xpv1.Selector `json:"volumeIdSelector,omitempty"`
+
+	// Tags: A flat array of tag names as strings to apply to the snapshot.
+	// +optional
+	// +immutable
+	Tags []string `json:"tags,omitempty"`
+}
+
+// A VolumeSnapshotSpec defines the desired state of a VolumeSnapshot.
+type VolumeSnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VolumeSnapshotParameters `json:"forProvider"`
+}
+
+// A VolumeSnapshotStatus represents the observed state of a VolumeSnapshot.
+type VolumeSnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VolumeSnapshot is a managed resource that represents a snapshot of a
+// DigitalOcean Volume. Its external name is the ID of the snapshot, which
+// can be used as the snapshot of a new Volume.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="SIZE",type="string",JSONPath=".status.atProvider.sizeGigabytes"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type VolumeSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeSnapshotSpec   `json:"spec"`
+	Status VolumeSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VolumeSnapshotList contains a list of VolumeSnapshot.
+type VolumeSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshot `json:"items"`
+}
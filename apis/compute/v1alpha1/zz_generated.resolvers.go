@@ -0,0 +1,324 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	v1alpha12 "github.com/crossplane-contrib/provider-digitalocean/apis/kubernetes/v1alpha1"
+	v1alpha11 "github.com/crossplane-contrib/provider-digitalocean/apis/loadbalancer/v1alpha1"
+	v1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/vpc/v1alpha1"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	errors "github.com/pkg/errors"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this Droplet.
+func (mg *Droplet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Image,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.ImageRef,
+		Selector:     mg.Spec.ForProvider.ImageSelector,
+		To: reference.To{
+			List:    &DropletSnapshotList{},
+			Managed: &DropletSnapshot{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Image")
+	}
+	mg.Spec.ForProvider.Image = rsp.ResolvedValue
+	mg.Spec.ForProvider.ImageRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCUUID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.VPCUUIDRef,
+		Selector:     mg.Spec.ForProvider.VPCUUIDSelector,
+		To: reference.To{
+			List:    &v1alpha1.VPCList{},
+			Managed: &v1alpha1.VPC{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.VPCUUID")
+	}
+	mg.Spec.ForProvider.VPCUUID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCUUIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this DropletSnapshot.
+func (mg *DropletSnapshot) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DropletID),
+		Extract:      DropletID(),
+		Reference:    mg.Spec.ForProvider.DropletIDRef,
+		Selector:     mg.Spec.ForProvider.DropletIDSelector,
+		To: reference.To{
+			List:    &DropletList{},
+			Managed: &Droplet{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.DropletID")
+	}
+	mg.Spec.ForProvider.DropletID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DropletIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Firewall.
+func (mg *Firewall) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.InboundRules); i3++ {
+		mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: mg.Spec.ForProvider.InboundRules[i3].Sources.DropletIDs,
+			Extract:       DropletID(),
+			References:    mg.Spec.ForProvider.InboundRules[i3].Sources.DropletIDRefs,
+			Selector:      mg.Spec.ForProvider.InboundRules[i3].Sources.DropletIDSelector,
+			To: reference.To{
+				List:    &DropletList{},
+				Managed: &Droplet{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.InboundRules[i3].Sources.DropletIDs")
+		}
+		mg.Spec.ForProvider.InboundRules[i3].Sources.DropletIDs = mrsp.ResolvedValues
+		mg.Spec.ForProvider.InboundRules[i3].Sources.DropletIDRefs = mrsp.ResolvedReferences
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.InboundRules); i3++ {
+		mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: mg.Spec.ForProvider.InboundRules[i3].Sources.LoadBalancerUIDs,
+			Extract:       reference.ExternalName(),
+			References:    mg.Spec.ForProvider.InboundRules[i3].Sources.LoadBalancerUIDRefs,
+			Selector:      mg.Spec.ForProvider.InboundRules[i3].Sources.LoadBalancerUIDSelector,
+			To: reference.To{
+				List:    &v1alpha11.LBList{},
+				Managed: &v1alpha11.LB{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.InboundRules[i3].Sources.LoadBalancerUIDs")
+		}
+		mg.Spec.ForProvider.InboundRules[i3].Sources.LoadBalancerUIDs = mrsp.ResolvedValues
+		mg.Spec.ForProvider.InboundRules[i3].Sources.LoadBalancerUIDRefs = mrsp.ResolvedReferences
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.InboundRules); i3++ {
+		mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: mg.Spec.ForProvider.InboundRules[i3].Sources.KubernetesIDs,
+			Extract:       reference.ExternalName(),
+			References:    mg.Spec.ForProvider.InboundRules[i3].Sources.KubernetesIDRefs,
+			Selector:      mg.Spec.ForProvider.InboundRules[i3].Sources.KubernetesIDSelector,
+			To: reference.To{
+				List:    &v1alpha12.DOKubernetesClusterList{},
+				Managed: &v1alpha12.DOKubernetesCluster{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.InboundRules[i3].Sources.KubernetesIDs")
+		}
+		mg.Spec.ForProvider.InboundRules[i3].Sources.KubernetesIDs = mrsp.ResolvedValues
+		mg.Spec.ForProvider.InboundRules[i3].Sources.KubernetesIDRefs = mrsp.ResolvedReferences
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.OutboundRules); i3++ {
+		mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: mg.Spec.ForProvider.OutboundRules[i3].Destinations.DropletIDs,
+			Extract:       DropletID(),
+			References:    mg.Spec.ForProvider.OutboundRules[i3].Destinations.DropletIDRefs,
+			Selector:      mg.Spec.ForProvider.OutboundRules[i3].Destinations.DropletIDSelector,
+			To: reference.To{
+				List:    &DropletList{},
+				Managed: &Droplet{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.OutboundRules[i3].Destinations.DropletIDs")
+		}
+		mg.Spec.ForProvider.OutboundRules[i3].Destinations.DropletIDs = mrsp.ResolvedValues
+		mg.Spec.ForProvider.OutboundRules[i3].Destinations.DropletIDRefs = mrsp.ResolvedReferences
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.OutboundRules); i3++ {
+		mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: mg.Spec.ForProvider.OutboundRules[i3].Destinations.LoadBalancerUIDs,
+			Extract:       reference.ExternalName(),
+			References:    mg.Spec.ForProvider.OutboundRules[i3].Destinations.LoadBalancerUIDRefs,
+			Selector:      mg.Spec.ForProvider.OutboundRules[i3].Destinations.LoadBalancerUIDSelector,
+			To: reference.To{
+				List:    &v1alpha11.LBList{},
+				Managed: &v1alpha11.LB{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.OutboundRules[i3].Destinations.LoadBalancerUIDs")
+		}
+		mg.Spec.ForProvider.OutboundRules[i3].Destinations.LoadBalancerUIDs = mrsp.ResolvedValues
+		mg.Spec.ForProvider.OutboundRules[i3].Destinations.LoadBalancerUIDRefs = mrsp.ResolvedReferences
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.OutboundRules); i3++ {
+		mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: mg.Spec.ForProvider.OutboundRules[i3].Destinations.KubernetesIDs,
+			Extract:       reference.ExternalName(),
+			References:    mg.Spec.ForProvider.OutboundRules[i3].Destinations.KubernetesIDRefs,
+			Selector:      mg.Spec.ForProvider.OutboundRules[i3].Destinations.KubernetesIDSelector,
+			To: reference.To{
+				List:    &v1alpha12.DOKubernetesClusterList{},
+				Managed: &v1alpha12.DOKubernetesCluster{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.OutboundRules[i3].Destinations.KubernetesIDs")
+		}
+		mg.Spec.ForProvider.OutboundRules[i3].Destinations.KubernetesIDs = mrsp.ResolvedValues
+		mg.Spec.ForProvider.OutboundRules[i3].Destinations.KubernetesIDRefs = mrsp.ResolvedReferences
+
+	}
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.DropletIDs,
+		Extract:       DropletID(),
+		References:    mg.Spec.ForProvider.DropletIDRefs,
+		Selector:      mg.Spec.ForProvider.DropletIDSelector,
+		To: reference.To{
+			List:    &DropletList{},
+			Managed: &Droplet{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.DropletIDs")
+	}
+	mg.Spec.ForProvider.DropletIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.DropletIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this Volume.
+func (mg *Volume) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SnapshotID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.SnapshotIDRef,
+		Selector:     mg.Spec.ForProvider.SnapshotIDSelector,
+		To: reference.To{
+			List:    &VolumeSnapshotList{},
+			Managed: &VolumeSnapshot{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.SnapshotID")
+	}
+	mg.Spec.ForProvider.SnapshotID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SnapshotIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this VolumeAttachment.
+func (mg *VolumeAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VolumeID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.VolumeIDRef,
+		Selector:     mg.Spec.ForProvider.VolumeIDSelector,
+		To: reference.To{
+			List:    &VolumeList{},
+			Managed: &Volume{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.VolumeID")
+	}
+	mg.Spec.ForProvider.VolumeID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VolumeIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DropletID),
+		Extract:      DropletID(),
+		Reference:    mg.Spec.ForProvider.DropletIDRef,
+		Selector:     mg.Spec.ForProvider.DropletIDSelector,
+		To: reference.To{
+			List:    &DropletList{},
+			Managed: &Droplet{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.DropletID")
+	}
+	mg.Spec.ForProvider.DropletID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DropletIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this VolumeSnapshot.
+func (mg *VolumeSnapshot) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VolumeID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.VolumeIDRef,
+		Selector:     mg.Spec.ForProvider.VolumeIDSelector,
+		To: reference.To{
+			List:    &VolumeList{},
+			Managed: &Volume{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.VolumeID")
+	}
+	mg.Spec.ForProvider.VolumeID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VolumeIDRef = rsp.ResolvedReference
+
+	return nil
+}
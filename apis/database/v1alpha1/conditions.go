@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Condition types used by the resources of this API group.
+const (
+	// TypeClusterUpdate indicates whether a Database Cluster is being resized
+	// or migrated to another region.
+	TypeClusterUpdate xpv1.ConditionType = "ClusterUpdate"
+
+	// TypeMaintenancePending indicates whether maintenance updates of a
+	// Database Cluster are pending.
+	TypeMaintenancePending xpv1.ConditionType = "MaintenancePending"
+)
+
+// Condition reasons used by the resources of this API group.
+const (
+	ReasonResizing       xpv1.ConditionReason = "Resizing"
+	ReasonMigrating      xpv1.ConditionReason = "Migrating"
+	ReasonUpdateComplete xpv1.ConditionReason = "UpdateComplete"
+
+	ReasonUpdatesPending    xpv1.ConditionReason = "UpdatesPending"
+	ReasonInstallingUpdates xpv1.ConditionReason = "InstallingUpdates"
+	ReasonNoUpdatesPending  xpv1.ConditionReason = "NoUpdatesPending"
+
+	ReasonForking       xpv1.ConditionReason = "Forking"
+	ReasonUnknownStatus xpv1.ConditionReason = "UnknownStatus"
+)
+
+// Resizing returns a condition that indicates the nodes of a Database Cluster
+// are being resized.
+func Resizing() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeClusterUpdate,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonResizing,
+	}
+}
+
+// Migrating returns a condition that indicates a Database Cluster is being
+// migrated to another region.
+func Migrating() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeClusterUpdate,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonMigrating,
+	}
+}
+
+// UpdateComplete returns a condition that indicates a Database Cluster is
+// neither being resized nor migrated.
+func UpdateComplete() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeClusterUpdate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpdateComplete,
+	}
+}
+
+// MaintenancePending returns a condition that indicates maintenance updates
+// with the supplied description are pending.
+func MaintenancePending(description string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeMaintenancePending,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpdatesPending,
+		Message:            description,
+	}
+}
+
+// InstallingUpdates returns a condition that indicates the installation of
+// pending maintenance updates was requested.
+func InstallingUpdates() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeMaintenancePending,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonInstallingUpdates,
+	}
+}
+
+// NoMaintenancePending returns a condition that indicates no maintenance
+// updates are pending.
+func NoMaintenancePending() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeMaintenancePending,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoUpdatesPending,
+	}
+}
+
+// Forking returns a condition that indicates a Database Cluster is unavailable
+// while it is being forked.
+func Forking() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonForking,
+	}
+}
+
+// UnknownStatus returns a condition that indicates a Database Cluster reports
+// the supplied status, which is not known to this provider.
+func UnknownStatus(status string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionUnknown,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnknownStatus,
+		Message:            "unknown status " + status,
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known connection pool modes
+const (
+	PoolModeSession     = "session"
+	PoolModeTransaction = "transaction"
+	PoolModeStatement   = "statement"
+)
+
+// A DODatabaseConnectionPoolParameters defines the desired state of a connection pool of a
+// DigitalOcean PostgreSQL Database Cluster.
+// All fields map directly to a Connection Pool
+// https://docs.digitalocean.com/reference/api/api-reference/#operation/add_connection_pool
+type DODatabaseConnectionPoolParameters struct {
+	// ClusterID: The ID of the Database Cluster the connection pool belongs to.
+	// Only PostgreSQL Database Clusters support connection pools.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=DODatabaseCluster
+	ClusterID *string `json:"clusterID,omitempty"`
+
+	// ClusterIDRef: A reference to the DODatabaseCluster the connection pool belongs to.
+	// +optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIDRef,omitempty"`
+
+	// ClusterIDSelector: Selects a DODatabaseCluster the connection pool belongs to.
+	// +optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIDSelector,omitempty"`
+
+	// Mode: The PgBouncer transaction mode for the connection pool.
+	// +kubebuilder:validation:Enum="session";"transaction";"statement"
+	Mode string `json:"mode"`
+
+	// Size: The desired size of the PgBouncer connection pool.
+	// +kubebuilder:validation:Minimum=1
+	Size int `json:"size"`
+
+	// Database: The database for use with the connection pool.
+	// +optional
+	// +crossplane:generate:reference:type=DODatabase
+	Database *string `json:"database,omitempty"`
+
+	// DatabaseRef: A reference to the DODatabase for use with the connection pool.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector: Selects a DODatabase for use with the connection pool.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// User: The database user for use with the connection pool.
+	// When not provided, the pool uses the credentials of the inbound client connection (Optional).
+	// +optional
+	// +crossplane:generate:reference:type=DODatabaseUser
+	User *string `json:"user,omitempty"`
+
+	// UserRef: A reference to the DODatabaseUser for use with the connection pool.
+	// +optional
+	UserRef *xpv1.Reference `json:"userRef,omitempty"`
+
+	// UserSelector: Selects a DODatabaseUser for use with the connection pool.
+	// +optional
+	UserSelector *xpv1.Selector `json:"userSelector,omitempty"`
+}
+
+// A DODatabaseConnectionPoolObservation reflects the observed state of a connection pool on DigitalOcean.
+type DODatabaseConnectionPoolObservation struct {
+	// The name of the connection pool.
+	Name string `json:"name"`
+
+	// The PgBouncer transaction mode of the connection pool.
+	Mode string `json:"mode,omitempty"`
+
+	// The size of the PgBouncer connection pool.
+	Size int `json:"size,omitempty"`
+
+	// The database used by the connection pool.
+	Database string `json:"database,omitempty"`
+
+	// The database user used by the connection pool.
+	User string `json:"user,omitempty"`
+}
+
+// A DODatabaseConnectionPoolSpec defines the desired state of a Connection Pool.
+type DODatabaseConnectionPoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DODatabaseConnectionPoolParameters `json:"forProvider"`
+}
+
+// A DODatabaseConnectionPoolStatus represents the observed state of a Connection Pool.
+type DODatabaseConnectionPoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DODatabaseConnectionPoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DODatabaseConnectionPool is a managed resource that represents a PgBouncer connection pool of a
+// DigitalOcean PostgreSQL Database Cluster. The name of the pool is the external name of the resource.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type DODatabaseConnectionPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DODatabaseConnectionPoolSpec   `json:"spec"`
+	Status DODatabaseConnectionPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DODatabaseConnectionPoolList contains a list of Connection Pools.
+type DODatabaseConnectionPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DODatabaseConnectionPool `json:"items"`
+}
@@ -28,6 +28,26 @@ const (
 	StatusForking   = "forking"
 )
 
+// Known Database Cluster engines
+const (
+	EnginePostgres = "pg"
+	EngineMySQL    = "mysql"
+	EngineRedis    = "redis"
+	EngineMongoDB  = "mongodb"
+)
+
+// Modes of installing pending updates of a Database Cluster.
+const (
+	InstallUpdatesImmediately         = "Immediately"
+	InstallUpdatesInMaintenanceWindow = "InMaintenanceWindow"
+)
+
+// Networks through which a Database Cluster can be reached.
+const (
+	EndpointNetworkPublic  = "public"
+	EndpointNetworkPrivate = "private"
+)
+
 // A DODatabaseClusterParameters defines the desired state of a DigitalOcean Database Cluster.
 // All fields map directly to a Database Cluster
 // https://docs.digitalocean.com/reference/api/api-reference/#operation/create_database_cluster
@@ -42,27 +62,161 @@ type DODatabaseClusterParameters struct {
 	// +immutable
 	Version *string `json:"version,omitempty"`
 
-	// NumNodes: The number of nodes in the database cluster.
-	// +immutable
+	// NumNodes: The number of nodes in the database cluster. Changing it resizes the cluster.
 	NumNodes int `json:"numNodes"`
 
-	// Size: The slug identifier representing the size of the nodes in the database cluster.
-	// +immutable
+	// Size: The slug identifier representing the size of the nodes in the database cluster. Changing it resizes the cluster.
 	Size string `json:"size"`
 
-	// Region: The slug identifier for the region where the database cluster is located.
-	// +immutable
+	// Region: The slug identifier for the region where the database cluster is located. Changing it migrates the cluster.
 	Region string `json:"region"`
 
 	// PrivateNetworkUUID: A string specifying the UUID of the VPC to which the database cluster will be assigned. If excluded, the cluster when creating a new database cluster, it will be assigned to your account's default VPC for the region (Optional).
 	// +optional
 	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-digitalocean/apis/vpc/v1alpha1.VPC
 	PrivateNetworkUUID *string `json:"privateNetworkUUID,omitempty"`
 
+	// PrivateNetworkUUIDRef: A reference to the VPC to which the database cluster will be assigned.
+	// +optional
+	PrivateNetworkUUIDRef *xpv1.Reference `json:"privateNetworkUUIDRef,omitempty"`
+
+	// PrivateNetworkUUIDSelector: Selects the VPC to which the database cluster will be assigned.
+	// +optional
+	PrivateNetworkUUIDSelector *xpv1.Selector `json:"privateNetworkUUIDSelector,omitempty"`
+
 	// Tags: An array of tags that have been applied to the database cluster (Optional).
 	// +optional
 	// +immutable
 	Tags []string `json:"tags,omitempty"`
+
+	// MaintenanceWindow: The window during which maintenance updates are applied to the database cluster (Optional).
+	// +optional
+	MaintenanceWindow *DODatabaseClusterMaintenanceWindowParameters `json:"maintenanceWindow,omitempty"`
+
+	// InstallUpdates: Installs pending maintenance updates of the database cluster either
+	// "Immediately" or "InMaintenanceWindow" (Optional). Pending updates are only reported
+	// when omitted.
+	// +kubebuilder:validation:Enum="Immediately";"InMaintenanceWindow"
+	// +optional
+	InstallUpdates *string `json:"installUpdates,omitempty"`
+
+	// TrustedSources: The sources that are allowed to connect to the database cluster (Optional).
+	// The trusted sources of the cluster are not managed when omitted, and any source
+	// with valid credentials can connect when the list is empty.
+	// +optional
+	TrustedSources []DODatabaseClusterTrustedSource `json:"trustedSources"`
+
+	// EndpointNetwork: The network of the connection URI published as the endpoint
+	// of the connection secret. Either "public" or "private" (Optional). Defaults to "public".
+	// +kubebuilder:validation:Enum="public";"private"
+	// +optional
+	EndpointNetwork *string `json:"endpointNetwork,omitempty"`
+
+	// RestoreFrom: The backup of an existing database cluster from which the cluster is created (Optional).
+	// +optional
+	// +immutable
+	RestoreFrom *DODatabaseClusterRestoreFrom `json:"restoreFrom,omitempty"`
+
+	// Redis: Settings that only apply to Redis database clusters (Optional).
+	// +optional
+	Redis *DODatabaseClusterRedisParameters `json:"redis,omitempty"`
+
+	// MySQL: Settings that only apply to MySQL database clusters (Optional).
+	// +optional
+	MySQL *DODatabaseClusterMySQLParameters `json:"mysql,omitempty"`
+}
+
+// A DODatabaseClusterRedisParameters defines the desired settings of a Redis Database Cluster.
+type DODatabaseClusterRedisParameters struct {
+	// EvictionPolicy: The policy used to evict keys when the cluster runs out of memory (Optional).
+	// +kubebuilder:validation:Enum="noeviction";"allkeys_lru";"allkeys_random";"volatile_lru";"volatile_random";"volatile_ttl"
+	// +optional
+	EvictionPolicy *string `json:"evictionPolicy,omitempty"`
+}
+
+// A DODatabaseClusterMySQLParameters defines the desired settings of a MySQL Database Cluster.
+type DODatabaseClusterMySQLParameters struct {
+	// SQLModes: The SQL modes of the cluster, e.g. "ANSI" or "STRICT_ALL_TABLES" (Optional).
+	// +optional
+	SQLModes []string `json:"sqlModes,omitempty"`
+}
+
+// A DODatabaseClusterRestoreFrom defines the backup of a Database Cluster from which a Database Cluster is restored.
+type DODatabaseClusterRestoreFrom struct {
+	// DatabaseName: The name of the database cluster to restore from.
+	// +optional
+	// +crossplane:generate:reference:type=DODatabaseCluster
+	// +crossplane:generate:reference:extractor=ClusterName()
+	DatabaseName *string `json:"databaseName,omitempty"`
+
+	// DatabaseNameRef: A reference to the DODatabaseCluster to restore from.
+	// +optional
+	DatabaseNameRef *xpv1.Reference `json:"databaseNameRef,omitempty"`
+
+	// DatabaseNameSelector: Selects the DODatabaseCluster to restore from.
+	// +optional
+	DatabaseNameSelector *xpv1.Selector `json:"databaseNameSelector,omitempty"`
+
+	// BackupCreatedAt: The timestamp of the backup to restore, in RFC 3339 format (Optional).
+	// The most recent backup is restored when omitted.
+	// +optional
+	// +kubebuilder:validation:Format=date-time
+	BackupCreatedAt *string `json:"backupCreatedAt,omitempty"`
+}
+
+// A DODatabaseClusterTrustedSource defines a source that is allowed to connect to a Database Cluster.
+// Exactly one kind of source must be specified.
+type DODatabaseClusterTrustedSource struct {
+	// IPAddr: An IP address or CIDR range.
+	// +optional
+	IPAddr *string `json:"ipAddr,omitempty"`
+
+	// DropletID: The ID of a Droplet.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1.Droplet
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1.DropletID()
+	DropletID *string `json:"dropletID,omitempty"`
+
+	// DropletIDRef: A reference to a Droplet.
+	// +optional
+	DropletIDRef *xpv1.Reference `json:"dropletIDRef,omitempty"`
+
+	// DropletIDSelector: Selects a Droplet.
+	// +optional
+	DropletIDSelector *xpv1.Selector `json:"dropletIDSelector,omitempty"`
+
+	// KubernetesClusterID: The UUID of a Kubernetes cluster.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-digitalocean/apis/kubernetes/v1alpha1.DOKubernetesCluster
+	KubernetesClusterID *string `json:"kubernetesClusterID,omitempty"`
+
+	// KubernetesClusterIDRef: A reference to a DOKubernetesCluster.
+	// +optional
+	KubernetesClusterIDRef *xpv1.Reference `json:"kubernetesClusterIDRef,omitempty"`
+
+	// KubernetesClusterIDSelector: Selects a DOKubernetesCluster.
+	// +optional
+	KubernetesClusterIDSelector *xpv1.Selector `json:"kubernetesClusterIDSelector,omitempty"`
+
+	// Tag: A tag applied to Droplets.
+	// +optional
+	Tag *string `json:"tag,omitempty"`
+
+	// AppID: The ID of an App Platform app.
+	// +optional
+	AppID *string `json:"appID,omitempty"`
+}
+
+// A DODatabaseClusterMaintenanceWindowParameters defines the desired Maintenance Window of a Database Cluster.
+type DODatabaseClusterMaintenanceWindowParameters struct {
+	// Day: The day of the week on which to apply maintenance updates.
+	// +kubebuilder:validation:Enum="monday";"tuesday";"wednesday";"thursday";"friday";"saturday";"sunday"
+	Day string `json:"day"`
+
+	// Hour: The hour in UTC at which maintenance updates will be applied in 24 hour format, e.g. "16:00".
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Hour string `json:"hour"`
 }
 
 // A DODatabaseClusterObservation reflects the observed state of a Database Cluster on DigitalOcean.
@@ -120,6 +274,46 @@ type DODatabaseClusterObservation struct {
 
 	// +kubebuilder:validation:Optional
 	MaintenanceWindow DODatabaseClusterMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// The sources that are allowed to connect to the database cluster.
+	// +kubebuilder:validation:Optional
+	TrustedSources []DODatabaseClusterFirewallRule `json:"trustedSources,omitempty"`
+
+	// The backups of the database cluster that are available for restoring.
+	// +kubebuilder:validation:Optional
+	Backups []DODatabaseClusterBackup `json:"backups,omitempty"`
+
+	// The policy used to evict keys of a Redis cluster.
+	// +kubebuilder:validation:Optional
+	EvictionPolicy string `json:"evictionPolicy,omitempty"`
+
+	// The SQL modes of a MySQL cluster.
+	// +kubebuilder:validation:Optional
+	SQLModes []string `json:"sqlModes,omitempty"`
+}
+
+// A DODatabaseClusterBackup reflects the observed state of a backup of a Database Cluster.
+type DODatabaseClusterBackup struct {
+	// The time at which the backup was created, in RFC 3339 format.
+	CreatedAt string `json:"createdAt"`
+
+	// The size of the backup in gigabytes.
+	SizeGigabytes string `json:"sizeGigabytes"`
+}
+
+// A DODatabaseClusterFirewallRule defines a source that is allowed to connect to a Database Cluster.
+type DODatabaseClusterFirewallRule struct {
+	// A unique ID for the firewall rule.
+	UUID string `json:"uuid,omitempty"`
+
+	// The type of the source. The possible values are "ip_addr", "droplet", "k8s", "tag" and "app".
+	Type string `json:"type"`
+
+	// The ID, tag or IP address of the source.
+	Value string `json:"value"`
+
+	// A time value given in ISO8601 combined date and time format that represents when the firewall rule was created.
+	CreatedAt string `json:"createdAt,omitempty"`
 }
 
 // A DODatabaseClusterConnection defines the connection information for a Database Cluster.
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DODatabaseUserParameters defines the desired state of a DigitalOcean Database User.
+// All fields map directly to a Database User
+// https://docs.digitalocean.com/reference/api/api-reference/#operation/add_database_user
+type DODatabaseUserParameters struct {
+	// ClusterID: The ID of the Database Cluster the user belongs to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=DODatabaseCluster
+	ClusterID *string `json:"clusterID,omitempty"`
+
+	// ClusterIDRef: A reference to the DODatabaseCluster the user belongs to.
+	// +optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIDRef,omitempty"`
+
+	// ClusterIDSelector: Selects a DODatabaseCluster the user belongs to.
+	// +optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIDSelector,omitempty"`
+
+	// MySQLSettings: The authentication settings of a user of a MySQL Database Cluster (Optional).
+	// Changing them resets the authentication of the user, which generates a new password.
+	// +optional
+	MySQLSettings *DODatabaseUserMySQLSettings `json:"mySQLSettings,omitempty"`
+
+	// PasswordGeneration: Increasing the generation resets the authentication of the user,
+	// which generates a new password that is written to the connection secret (Optional).
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	PasswordGeneration int64 `json:"passwordGeneration,omitempty"`
+}
+
+// A DODatabaseUserObservation reflects the observed state of a Database User on DigitalOcean.
+type DODatabaseUserObservation struct {
+	// The name of the database user.
+	Name string `json:"name"`
+
+	// A string representing the database user's role. The value will be either "primary" or "normal".
+	Role string `json:"role,omitempty"`
+
+	// The authentication settings of a user of a MySQL Database Cluster.
+	// +kubebuilder:validation:Optional
+	MySQLSettings *DODatabaseUserMySQLSettings `json:"mySQLSettings,omitempty"`
+
+	// The password generation that was most recently applied to the user.
+	// +kubebuilder:validation:Optional
+	PasswordGeneration int64 `json:"passwordGeneration,omitempty"`
+
+	// The time at which the authentication of the user was most recently reset.
+	// +kubebuilder:validation:Optional
+	PasswordResetAt *metav1.Time `json:"passwordResetAt,omitempty"`
+}
+
+// A DODatabaseUserSpec defines the desired state of a Database User.
+type DODatabaseUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DODatabaseUserParameters `json:"forProvider"`
+}
+
+// A DODatabaseUserStatus represents the observed state of a Database User.
+type DODatabaseUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DODatabaseUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DODatabaseUser is a managed resource that represents a user of a DigitalOcean Database Cluster.
+// The name of the user is the external name of the resource.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type DODatabaseUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DODatabaseUserSpec   `json:"spec"`
+	Status DODatabaseUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DODatabaseUserList contains a list of Database Users.
+type DODatabaseUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DODatabaseUser `json:"items"`
+}
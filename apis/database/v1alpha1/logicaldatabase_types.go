@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DODatabaseParameters defines the desired state of a database within a DigitalOcean Database Cluster.
+// All fields map directly to a Database
+// https://docs.digitalocean.com/reference/api/api-reference/#operation/add_database
+type DODatabaseParameters struct {
+	// ClusterID: The ID of the Database Cluster the database belongs to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=DODatabaseCluster
+	ClusterID *string `json:"clusterID,omitempty"`
+
+	// ClusterIDRef: A reference to the DODatabaseCluster the database belongs to.
+	// +optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIDRef,omitempty"`
+
+	// ClusterIDSelector: Selects a DODatabaseCluster the database belongs to.
+	// +optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIDSelector,omitempty"`
+}
+
+// A DODatabaseObservation reflects the observed state of a database within a Database Cluster on DigitalOcean.
+type DODatabaseObservation struct {
+	// The name of the database.
+	Name string `json:"name"`
+}
+
+// A DODatabaseSpec defines the desired state of a Database.
+type DODatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DODatabaseParameters `json:"forProvider"`
+}
+
+// A DODatabaseStatus represents the observed state of a Database.
+type DODatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DODatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DODatabase is a managed resource that represents a logical database within a DigitalOcean Database Cluster.
+// The name of the database is the external name of the resource.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type DODatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DODatabaseSpec   `json:"spec"`
+	Status DODatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DODatabaseList contains a list of Databases.
+type DODatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DODatabase `json:"items"`
+}
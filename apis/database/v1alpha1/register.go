@@ -42,6 +42,42 @@ var (
 	DBGroupVersionKind = SchemeGroupVersion.WithKind(DBKind)
 )
 
+// DODatabaseUser type metadata.
+var (
+	DODatabaseUserKind             = reflect.TypeOf(DODatabaseUser{}).Name()
+	DODatabaseUserGroupKind        = schema.GroupKind{Group: Group, Kind: DODatabaseUserKind}.String()
+	DODatabaseUserKindAPIVersion   = DODatabaseUserKind + "." + SchemeGroupVersion.String()
+	DODatabaseUserGroupVersionKind = SchemeGroupVersion.WithKind(DODatabaseUserKind)
+)
+
+// DODatabase type metadata.
+var (
+	DODatabaseKind             = reflect.TypeOf(DODatabase{}).Name()
+	DODatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: DODatabaseKind}.String()
+	DODatabaseKindAPIVersion   = DODatabaseKind + "." + SchemeGroupVersion.String()
+	DODatabaseGroupVersionKind = SchemeGroupVersion.WithKind(DODatabaseKind)
+)
+
+// DODatabaseConnectionPool type metadata.
+var (
+	DODatabaseConnectionPoolKind             = reflect.TypeOf(DODatabaseConnectionPool{}).Name()
+	DODatabaseConnectionPoolGroupKind        = schema.GroupKind{Group: Group, Kind: DODatabaseConnectionPoolKind}.String()
+	DODatabaseConnectionPoolKindAPIVersion   = DODatabaseConnectionPoolKind + "." + SchemeGroupVersion.String()
+	DODatabaseConnectionPoolGroupVersionKind = SchemeGroupVersion.WithKind(DODatabaseConnectionPoolKind)
+)
+
+// DODatabaseReplica type metadata.
+var (
+	DODatabaseReplicaKind             = reflect.TypeOf(DODatabaseReplica{}).Name()
+	DODatabaseReplicaGroupKind        = schema.GroupKind{Group: Group, Kind: DODatabaseReplicaKind}.String()
+	DODatabaseReplicaKindAPIVersion   = DODatabaseReplicaKind + "." + SchemeGroupVersion.String()
+	DODatabaseReplicaGroupVersionKind = SchemeGroupVersion.WithKind(DODatabaseReplicaKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&DODatabaseCluster{}, &DODatabaseClusterList{})
+	SchemeBuilder.Register(&DODatabaseUser{}, &DODatabaseUserList{})
+	SchemeBuilder.Register(&DODatabase{}, &DODatabaseList{})
+	SchemeBuilder.Register(&DODatabaseConnectionPool{}, &DODatabaseConnectionPoolList{})
+	SchemeBuilder.Register(&DODatabaseReplica{}, &DODatabaseReplicaList{})
 }
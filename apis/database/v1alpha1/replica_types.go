@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DODatabaseReplicaParameters defines the desired state of a read-only replica of a DigitalOcean Database Cluster.
+// All fields map directly to a Read-only Replica
+// https://docs.digitalocean.com/reference/api/api-reference/#operation/create_replica
+type DODatabaseReplicaParameters struct {
+	// ClusterID: The ID of the Database Cluster the replica reads from.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=DODatabaseCluster
+	ClusterID *string `json:"clusterID,omitempty"`
+
+	// ClusterIDRef: A reference to the DODatabaseCluster the replica reads from.
+	// +optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIDRef,omitempty"`
+
+	// ClusterIDSelector: Selects a DODatabaseCluster the replica reads from.
+	// +optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIDSelector,omitempty"`
+
+	// Region: The slug identifier for the region where the replica is located.
+	// Defaults to the region of the Database Cluster (Optional).
+	// +optional
+	// +immutable
+	Region *string `json:"region,omitempty"`
+
+	// Size: The slug identifier representing the size of the node of the replica.
+	// +immutable
+	Size string `json:"size"`
+
+	// PrivateNetworkUUID: A string specifying the UUID of the VPC to which the replica will be assigned.
+	// If excluded, the replica will be assigned to your account's default VPC for the region (Optional).
+	// +optional
+	// +immutable
+	PrivateNetworkUUID *string `json:"privateNetworkUUID,omitempty"`
+
+	// Tags: An array of tags to apply to the replica (Optional).
+	// +optional
+	// +immutable
+	Tags []string `json:"tags,omitempty"`
+}
+
+// A DODatabaseReplicaObservation reflects the observed state of a read-only replica on DigitalOcean.
+type DODatabaseReplicaObservation struct {
+	// The name of the replica.
+	Name string `json:"name"`
+
+	// The slug identifier for the region where the replica is located.
+	Region string `json:"region,omitempty"`
+
+	// A string representing the current status of the replica.
+	Status string `json:"status,omitempty"`
+
+	// A time value given in ISO8601 combined date and time format that represents when the replica was created.
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	// A string specifying the UUID of the VPC to which the replica is assigned.
+	PrivateNetworkUUID string `json:"privateNetworkUUID,omitempty"`
+
+	// An array of tags that have been applied to the replica.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// A DODatabaseReplicaSpec defines the desired state of a Replica.
+type DODatabaseReplicaSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DODatabaseReplicaParameters `json:"forProvider"`
+}
+
+// A DODatabaseReplicaStatus represents the observed state of a Replica.
+type DODatabaseReplicaStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DODatabaseReplicaObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DODatabaseReplica is a managed resource that represents a read-only replica of a DigitalOcean Database Cluster.
+// The name of the replica is the external name of the resource.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type DODatabaseReplica struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DODatabaseReplicaSpec   `json:"spec"`
+	Status DODatabaseReplicaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DODatabaseReplicaList contains a list of Replicas.
+type DODatabaseReplicaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DODatabaseReplica `json:"items"`
+}
@@ -22,9 +22,37 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabase) DeepCopyInto(out *DODatabase) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabase.
+func (in *DODatabase) DeepCopy() *DODatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DODatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DODatabaseCluster) DeepCopyInto(out *DODatabaseCluster) {
 	*out = *in
@@ -52,6 +80,21 @@ func (in *DODatabaseCluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseClusterBackup) DeepCopyInto(out *DODatabaseClusterBackup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseClusterBackup.
+func (in *DODatabaseClusterBackup) DeepCopy() *DODatabaseClusterBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseClusterBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DODatabaseClusterConnection) DeepCopyInto(out *DODatabaseClusterConnection) {
 	*out = *in
@@ -102,6 +145,21 @@ func (in *DODatabaseClusterConnection) DeepCopy() *DODatabaseClusterConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseClusterFirewallRule) DeepCopyInto(out *DODatabaseClusterFirewallRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseClusterFirewallRule.
+func (in *DODatabaseClusterFirewallRule) DeepCopy() *DODatabaseClusterFirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseClusterFirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DODatabaseClusterList) DeepCopyInto(out *DODatabaseClusterList) {
 	*out = *in
@@ -154,6 +212,41 @@ func (in *DODatabaseClusterMaintenanceWindow) DeepCopy() *DODatabaseClusterMaint
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseClusterMaintenanceWindowParameters) DeepCopyInto(out *DODatabaseClusterMaintenanceWindowParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseClusterMaintenanceWindowParameters.
+func (in *DODatabaseClusterMaintenanceWindowParameters) DeepCopy() *DODatabaseClusterMaintenanceWindowParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseClusterMaintenanceWindowParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseClusterMySQLParameters) DeepCopyInto(out *DODatabaseClusterMySQLParameters) {
+	*out = *in
+	if in.SQLModes != nil {
+		in, out := &in.SQLModes, &out.SQLModes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseClusterMySQLParameters.
+func (in *DODatabaseClusterMySQLParameters) DeepCopy() *DODatabaseClusterMySQLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseClusterMySQLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DODatabaseClusterObservation) DeepCopyInto(out *DODatabaseClusterObservation) {
 	*out = *in
@@ -180,6 +273,21 @@ func (in *DODatabaseClusterObservation) DeepCopyInto(out *DODatabaseClusterObser
 		copy(*out, *in)
 	}
 	in.MaintenanceWindow.DeepCopyInto(&out.MaintenanceWindow)
+	if in.TrustedSources != nil {
+		in, out := &in.TrustedSources, &out.TrustedSources
+		*out = make([]DODatabaseClusterFirewallRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]DODatabaseClusterBackup, len(*in))
+		copy(*out, *in)
+	}
+	if in.SQLModes != nil {
+		in, out := &in.SQLModes, &out.SQLModes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseClusterObservation.
@@ -210,11 +318,58 @@ func (in *DODatabaseClusterParameters) DeepCopyInto(out *DODatabaseClusterParame
 		*out = new(string)
 		**out = **in
 	}
+	if in.PrivateNetworkUUIDRef != nil {
+		in, out := &in.PrivateNetworkUUIDRef, &out.PrivateNetworkUUIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PrivateNetworkUUIDSelector != nil {
+		in, out := &in.PrivateNetworkUUIDSelector, &out.PrivateNetworkUUIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(DODatabaseClusterMaintenanceWindowParameters)
+		**out = **in
+	}
+	if in.InstallUpdates != nil {
+		in, out := &in.InstallUpdates, &out.InstallUpdates
+		*out = new(string)
+		**out = **in
+	}
+	if in.TrustedSources != nil {
+		in, out := &in.TrustedSources, &out.TrustedSources
+		*out = make([]DODatabaseClusterTrustedSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EndpointNetwork != nil {
+		in, out := &in.EndpointNetwork, &out.EndpointNetwork
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(DODatabaseClusterRestoreFrom)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(DODatabaseClusterRedisParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MySQL != nil {
+		in, out := &in.MySQL, &out.MySQL
+		*out = new(DODatabaseClusterMySQLParameters)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseClusterParameters.
@@ -227,6 +382,61 @@ func (in *DODatabaseClusterParameters) DeepCopy() *DODatabaseClusterParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseClusterRedisParameters) DeepCopyInto(out *DODatabaseClusterRedisParameters) {
+	*out = *in
+	if in.EvictionPolicy != nil {
+		in, out := &in.EvictionPolicy, &out.EvictionPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseClusterRedisParameters.
+func (in *DODatabaseClusterRedisParameters) DeepCopy() *DODatabaseClusterRedisParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseClusterRedisParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseClusterRestoreFrom) DeepCopyInto(out *DODatabaseClusterRestoreFrom) {
+	*out = *in
+	if in.DatabaseName != nil {
+		in, out := &in.DatabaseName, &out.DatabaseName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseNameRef != nil {
+		in, out := &in.DatabaseNameRef, &out.DatabaseNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DatabaseNameSelector != nil {
+		in, out := &in.DatabaseNameSelector, &out.DatabaseNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupCreatedAt != nil {
+		in, out := &in.BackupCreatedAt, &out.BackupCreatedAt
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseClusterRestoreFrom.
+func (in *DODatabaseClusterRestoreFrom) DeepCopy() *DODatabaseClusterRestoreFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseClusterRestoreFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DODatabaseClusterSpec) DeepCopyInto(out *DODatabaseClusterSpec) {
 	*out = *in
@@ -261,6 +471,66 @@ func (in *DODatabaseClusterStatus) DeepCopy() *DODatabaseClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseClusterTrustedSource) DeepCopyInto(out *DODatabaseClusterTrustedSource) {
+	*out = *in
+	if in.IPAddr != nil {
+		in, out := &in.IPAddr, &out.IPAddr
+		*out = new(string)
+		**out = **in
+	}
+	if in.DropletID != nil {
+		in, out := &in.DropletID, &out.DropletID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DropletIDRef != nil {
+		in, out := &in.DropletIDRef, &out.DropletIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DropletIDSelector != nil {
+		in, out := &in.DropletIDSelector, &out.DropletIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesClusterID != nil {
+		in, out := &in.KubernetesClusterID, &out.KubernetesClusterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KubernetesClusterIDRef != nil {
+		in, out := &in.KubernetesClusterIDRef, &out.KubernetesClusterIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KubernetesClusterIDSelector != nil {
+		in, out := &in.KubernetesClusterIDSelector, &out.KubernetesClusterIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(string)
+		**out = **in
+	}
+	if in.AppID != nil {
+		in, out := &in.AppID, &out.AppID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseClusterTrustedSource.
+func (in *DODatabaseClusterTrustedSource) DeepCopy() *DODatabaseClusterTrustedSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseClusterTrustedSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DODatabaseClusterUser) DeepCopyInto(out *DODatabaseClusterUser) {
 	*out = *in
@@ -278,16 +548,605 @@ func (in *DODatabaseClusterUser) DeepCopy() *DODatabaseClusterUser {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DODatabaseUserMySQLSettings) DeepCopyInto(out *DODatabaseUserMySQLSettings) {
+func (in *DODatabaseConnectionPool) DeepCopyInto(out *DODatabaseConnectionPool) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseUserMySQLSettings.
-func (in *DODatabaseUserMySQLSettings) DeepCopy() *DODatabaseUserMySQLSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseConnectionPool.
+func (in *DODatabaseConnectionPool) DeepCopy() *DODatabaseConnectionPool {
 	if in == nil {
 		return nil
 	}
-	out := new(DODatabaseUserMySQLSettings)
+	out := new(DODatabaseConnectionPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DODatabaseConnectionPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseConnectionPoolList) DeepCopyInto(out *DODatabaseConnectionPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DODatabaseConnectionPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseConnectionPoolList.
+func (in *DODatabaseConnectionPoolList) DeepCopy() *DODatabaseConnectionPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseConnectionPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DODatabaseConnectionPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseConnectionPoolObservation) DeepCopyInto(out *DODatabaseConnectionPoolObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseConnectionPoolObservation.
+func (in *DODatabaseConnectionPoolObservation) DeepCopy() *DODatabaseConnectionPoolObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseConnectionPoolObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseConnectionPoolParameters) DeepCopyInto(out *DODatabaseConnectionPoolParameters) {
+	*out = *in
+	if in.ClusterID != nil {
+		in, out := &in.ClusterID, &out.ClusterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterIDRef != nil {
+		in, out := &in.ClusterIDRef, &out.ClusterIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterIDSelector != nil {
+		in, out := &in.ClusterIDSelector, &out.ClusterIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserRef != nil {
+		in, out := &in.UserRef, &out.UserRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.UserSelector != nil {
+		in, out := &in.UserSelector, &out.UserSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseConnectionPoolParameters.
+func (in *DODatabaseConnectionPoolParameters) DeepCopy() *DODatabaseConnectionPoolParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseConnectionPoolParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseConnectionPoolSpec) DeepCopyInto(out *DODatabaseConnectionPoolSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseConnectionPoolSpec.
+func (in *DODatabaseConnectionPoolSpec) DeepCopy() *DODatabaseConnectionPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseConnectionPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseConnectionPoolStatus) DeepCopyInto(out *DODatabaseConnectionPoolStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseConnectionPoolStatus.
+func (in *DODatabaseConnectionPoolStatus) DeepCopy() *DODatabaseConnectionPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseConnectionPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseList) DeepCopyInto(out *DODatabaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DODatabase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseList.
+func (in *DODatabaseList) DeepCopy() *DODatabaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DODatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseObservation) DeepCopyInto(out *DODatabaseObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseObservation.
+func (in *DODatabaseObservation) DeepCopy() *DODatabaseObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseParameters) DeepCopyInto(out *DODatabaseParameters) {
+	*out = *in
+	if in.ClusterID != nil {
+		in, out := &in.ClusterID, &out.ClusterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterIDRef != nil {
+		in, out := &in.ClusterIDRef, &out.ClusterIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterIDSelector != nil {
+		in, out := &in.ClusterIDSelector, &out.ClusterIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseParameters.
+func (in *DODatabaseParameters) DeepCopy() *DODatabaseParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseReplica) DeepCopyInto(out *DODatabaseReplica) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseReplica.
+func (in *DODatabaseReplica) DeepCopy() *DODatabaseReplica {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseReplica)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DODatabaseReplica) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseReplicaList) DeepCopyInto(out *DODatabaseReplicaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DODatabaseReplica, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseReplicaList.
+func (in *DODatabaseReplicaList) DeepCopy() *DODatabaseReplicaList {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseReplicaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DODatabaseReplicaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseReplicaObservation) DeepCopyInto(out *DODatabaseReplicaObservation) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseReplicaObservation.
+func (in *DODatabaseReplicaObservation) DeepCopy() *DODatabaseReplicaObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseReplicaObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseReplicaParameters) DeepCopyInto(out *DODatabaseReplicaParameters) {
+	*out = *in
+	if in.ClusterID != nil {
+		in, out := &in.ClusterID, &out.ClusterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterIDRef != nil {
+		in, out := &in.ClusterIDRef, &out.ClusterIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterIDSelector != nil {
+		in, out := &in.ClusterIDSelector, &out.ClusterIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateNetworkUUID != nil {
+		in, out := &in.PrivateNetworkUUID, &out.PrivateNetworkUUID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseReplicaParameters.
+func (in *DODatabaseReplicaParameters) DeepCopy() *DODatabaseReplicaParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseReplicaParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseReplicaSpec) DeepCopyInto(out *DODatabaseReplicaSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseReplicaSpec.
+func (in *DODatabaseReplicaSpec) DeepCopy() *DODatabaseReplicaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseReplicaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseReplicaStatus) DeepCopyInto(out *DODatabaseReplicaStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseReplicaStatus.
+func (in *DODatabaseReplicaStatus) DeepCopy() *DODatabaseReplicaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseReplicaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseSpec) DeepCopyInto(out *DODatabaseSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseSpec.
+func (in *DODatabaseSpec) DeepCopy() *DODatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseStatus) DeepCopyInto(out *DODatabaseStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseStatus.
+func (in *DODatabaseStatus) DeepCopy() *DODatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseUser) DeepCopyInto(out *DODatabaseUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseUser.
+func (in *DODatabaseUser) DeepCopy() *DODatabaseUser {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DODatabaseUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseUserList) DeepCopyInto(out *DODatabaseUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DODatabaseUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseUserList.
+func (in *DODatabaseUserList) DeepCopy() *DODatabaseUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DODatabaseUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseUserMySQLSettings) DeepCopyInto(out *DODatabaseUserMySQLSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseUserMySQLSettings.
+func (in *DODatabaseUserMySQLSettings) DeepCopy() *DODatabaseUserMySQLSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseUserMySQLSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseUserObservation) DeepCopyInto(out *DODatabaseUserObservation) {
+	*out = *in
+	if in.MySQLSettings != nil {
+		in, out := &in.MySQLSettings, &out.MySQLSettings
+		*out = new(DODatabaseUserMySQLSettings)
+		**out = **in
+	}
+	if in.PasswordResetAt != nil {
+		in, out := &in.PasswordResetAt, &out.PasswordResetAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseUserObservation.
+func (in *DODatabaseUserObservation) DeepCopy() *DODatabaseUserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseUserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseUserParameters) DeepCopyInto(out *DODatabaseUserParameters) {
+	*out = *in
+	if in.ClusterID != nil {
+		in, out := &in.ClusterID, &out.ClusterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterIDRef != nil {
+		in, out := &in.ClusterIDRef, &out.ClusterIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterIDSelector != nil {
+		in, out := &in.ClusterIDSelector, &out.ClusterIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MySQLSettings != nil {
+		in, out := &in.MySQLSettings, &out.MySQLSettings
+		*out = new(DODatabaseUserMySQLSettings)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseUserParameters.
+func (in *DODatabaseUserParameters) DeepCopy() *DODatabaseUserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseUserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseUserSpec) DeepCopyInto(out *DODatabaseUserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseUserSpec.
+func (in *DODatabaseUserSpec) DeepCopy() *DODatabaseUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DODatabaseUserStatus) DeepCopyInto(out *DODatabaseUserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DODatabaseUserStatus.
+func (in *DODatabaseUserStatus) DeepCopy() *DODatabaseUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DODatabaseUserStatus)
 	in.DeepCopyInto(out)
 	return out
 }
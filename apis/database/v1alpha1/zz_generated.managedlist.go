@@ -27,3 +27,39 @@ func (l *DODatabaseClusterList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this DODatabaseConnectionPoolList.
+func (l *DODatabaseConnectionPoolList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this DODatabaseList.
+func (l *DODatabaseList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this DODatabaseReplicaList.
+func (l *DODatabaseReplicaList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this DODatabaseUserList.
+func (l *DODatabaseUserList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -22,9 +22,11 @@ import (
 
 	computev1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
 	dbv1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	dnsv1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/dns/v1alpha1"
 	kubev1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/kubernetes/v1alpha1"
 	lbv1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/loadbalancer/v1alpha1"
 	dov1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/v1alpha1"
+	vpcv1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/vpc/v1alpha1"
 )
 
 func init() {
@@ -33,8 +35,10 @@ func init() {
 		dov1alpha1.SchemeBuilder.AddToScheme,
 		computev1alpha1.SchemeBuilder.AddToScheme,
 		dbv1alpha1.SchemeBuilder.AddToScheme,
+		dnsv1alpha1.SchemeBuilder.AddToScheme,
 		kubev1alpha1.SchemeBuilder.AddToScheme,
 		lbv1alpha1.SchemeBuilder.AddToScheme,
+		vpcv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DomainParameters define the desired state of a DigitalOcean Domain. The
+// name of the Domain is the external name of the managed resource, which
+// defaults to its name. Existing Domains are imported by setting the external
+// name to their name, which leaves their records untouched.
+// https://docs.digitalocean.com/reference/api/api-reference/#tag/Domains
+type DomainParameters struct {
+	// IPAddress: An IPv4 address for which an A record pointing to the apex
+	// of the Domain is created along with the Domain. It is ignored for
+	// Domains that already exist.
+	// +optional
+	// +immutable
+	IPAddress *string `json:"ipAddress,omitempty"`
+}
+
+// A DomainObservation reflects the observed state of a Domain on
+// DigitalOcean.
+type DomainObservation struct {
+	// Name of the Domain.
+	Name string `json:"name,omitempty"`
+
+	// URN of the Domain.
+	URN string `json:"urn,omitempty"`
+
+	// TTL in seconds of the records of the Domain that do not set one.
+	TTL int `json:"ttl,omitempty"`
+
+	// NameServers of the NS records at the apex of the Domain.
+	NameServers []string `json:"nameServers,omitempty"`
+
+	// ZoneFile of the Domain. It may stay empty for a while after the Domain
+	// is created.
+	ZoneFile string `json:"zoneFile,omitempty"`
+}
+
+// A DomainSpec defines the desired state of a Domain.
+type DomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// +optional
+	ForProvider DomainParameters `json:"forProvider"`
+}
+
+// A DomainStatus represents the observed state of a Domain.
+type DomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Domain is a managed resource that represents a DigitalOcean DNS Domain.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TTL",type="integer",JSONPath=".status.atProvider.ttl"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type Domain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainSpec   `json:"spec"`
+	Status DomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainList contains a list of Domain.
+type DomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Domain `json:"items"`
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Types of DomainRecords.
+const (
+	RecordTypeA     = "A"
+	RecordTypeAAAA  = "AAAA"
+	RecordTypeCNAME = "CNAME"
+	RecordTypeMX    = "MX"
+	RecordTypeTXT   = "TXT"
+	RecordTypeNS    = "NS"
+	RecordTypeSRV   = "SRV"
+	RecordTypeCAA   = "CAA"
+)
+
+// DomainRecordParameters define the desired state of a DigitalOcean
+// DomainRecord. Most fields map directly to a Domain Record:
+// https://docs.digitalocean.com/reference/api/api-reference/#tag/Domain-Records
+type DomainRecordParameters struct {
+	// Domain: The name of the Domain the record belongs to.
+	// +optional
+	// +immutable
+	Domain string `json:"domain,omitempty"`
+
+	// DomainRef: A reference to the Domain the record belongs to.
+	// +optional
+	DomainRef *xpv1.Reference `json:"domainRef,omitempty"`
+
+	// DomainSelector: Selects the Domain the record belongs to.
+	// +optional
+	DomainSelector *xpv1.Selector `json:"domainSelector,omitempty"`
+
+	// Type: The type of the record.
+	// +immutable
+	// +kubebuilder:validation:Enum=A;AAAA;CNAME;MX;TXT;NS;SRV;CAA
+	Type string `json:"type"`
+
+	// Name: The host name of the record relative to the Domain, or "@" for
+	// the Domain itself.
+	Name string `json:"name"`
+
+	// Data: The value of the record, e.g. the address of A and AAAA records
+	// or the host name of CNAME, MX, NS and SRV records. Host names that do
+	// not belong to the Domain must end with a dot.
+	// +optional
+	Data *string `json:"data,omitempty"`
+
+	// DataDropletRef: A reference to a Droplet whose public IPv4 (A records)
+	// or IPv6 (AAAA records) address is the value of the record. The value
+	// follows the address of the Droplet whenever it changes.
+	// +optional
+	DataDropletRef *xpv1.Reference `json:"dataDropletRef,omitempty"`
+
+	// DataDropletSelector: Selects a Droplet whose public IPv4 (A records)
+	// or IPv6 (AAAA records) address is the value of the record.
+	// +optional
+	DataDropletSelector *xpv1.Selector `json:"dataDropletSelector,omitempty"`
+
+	// DataLoadBalancerRef: A reference to a LB whose IPv4 address is the
+	// value of an A record. The value follows the address of the LB
+	// whenever it changes.
+	// +optional
+	DataLoadBalancerRef *xpv1.Reference `json:"dataLoadBalancerRef,omitempty"`
+
+	// DataLoadBalancerSelector: Selects a LB whose IPv4 address is the value
+	// of an A record.
+	// +optional
+	DataLoadBalancerSelector *xpv1.Selector `json:"dataLoadBalancerSelector,omitempty"`
+
+	// Priority: The priority of MX and SRV records.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Priority *int `json:"priority,omitempty"`
+
+	// Port: The port of SRV records.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Port *int `json:"port,omitempty"`
+
+	// Weight: The weight of SRV records.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Weight *int `json:"weight,omitempty"`
+
+	// Flags: The flags of CAA records.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Flags *int `json:"flags,omitempty"`
+
+	// Tag: The parameter tag of CAA records.
+	// +optional
+	// +kubebuilder:validation:Enum=issue;issuewild;iodef
+	Tag *string `json:"tag,omitempty"`
+
+	// TTL: The time to live of the record in seconds. Defaults to the TTL of
+	// the Domain.
+	// +optional
+	// +kubebuilder:validation:Minimum=30
+	TTL *int `json:"ttl,omitempty"`
+}
+
+// A DomainRecordObservation reflects the observed state of a DomainRecord on
+// DigitalOcean.
+type DomainRecordObservation struct {
+	// ID for the resource. This identifier is defined by the server.
+	ID int `json:"id,omitempty"`
+
+	// Type of the record.
+	Type string `json:"type,omitempty"`
+
+	// Name of the record.
+	Name string `json:"name,omitempty"`
+
+	// Data of the record.
+	Data string `json:"data,omitempty"`
+
+	// Priority of the record.
+	Priority int `json:"priority,omitempty"`
+
+	// Port of the record.
+	Port int `json:"port,omitempty"`
+
+	// Weight of the record.
+	Weight int `json:"weight,omitempty"`
+
+	// Flags of the record.
+	Flags int `json:"flags,omitempty"`
+
+	// Tag of the record.
+	Tag string `json:"tag,omitempty"`
+
+	// TTL of the record in seconds.
+	TTL int `json:"ttl,omitempty"`
+}
+
+// A DomainRecordSpec defines the desired state of a DomainRecord.
+type DomainRecordSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DomainRecordParameters `json:"forProvider"`
+}
+
+// A DomainRecordStatus represents the observed state of a DomainRecord.
+type DomainRecordStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DomainRecordObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DomainRecord is a managed resource that represents a DNS record of a
+// DigitalOcean Domain.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name"
+// +kubebuilder:printcolumn:name="DATA",type="string",JSONPath=".status.atProvider.data"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type DomainRecord struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainRecordSpec   `json:"spec"`
+	Status DomainRecordStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainRecordList contains a list of DomainRecord.
+type DomainRecordList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DomainRecord `json:"items"`
+}
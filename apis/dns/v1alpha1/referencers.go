@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	computev1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	lbv1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/loadbalancer/v1alpha1"
+)
+
+const errLBRecordType = "the address of a LB can only be the data of A records, not of %s records"
+
+// ResolveReferences of this DomainRecord. Unlike generated resolvers, which
+// keep values once they are resolved, it resolves the data of the record
+// from the referenced Droplet or LB every time it is called. This keeps the
+// record pointing at their current address.
+func (mg *DomainRecord) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Domain,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.DomainRef,
+		Selector:     mg.Spec.ForProvider.DomainSelector,
+		To: reference.To{
+			List:    &DomainList{},
+			Managed: &Domain{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Domain")
+	}
+	mg.Spec.ForProvider.Domain = rsp.ResolvedValue
+	mg.Spec.ForProvider.DomainRef = rsp.ResolvedReference
+
+	switch {
+	case mg.Spec.ForProvider.DataDropletRef != nil || mg.Spec.ForProvider.DataDropletSelector != nil:
+		extract := computev1alpha1.DropletPublicIPv4()
+		if mg.Spec.ForProvider.Type == RecordTypeAAAA {
+			extract = computev1alpha1.DropletPublicIPv6()
+		}
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			Extract:   extract,
+			Reference: mg.Spec.ForProvider.DataDropletRef,
+			Selector:  mg.Spec.ForProvider.DataDropletSelector,
+			To: reference.To{
+				List:    &computev1alpha1.DropletList{},
+				Managed: &computev1alpha1.Droplet{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Data")
+		}
+		mg.Spec.ForProvider.Data = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.DataDropletRef = rsp.ResolvedReference
+
+	case mg.Spec.ForProvider.DataLoadBalancerRef != nil || mg.Spec.ForProvider.DataLoadBalancerSelector != nil:
+		if mg.Spec.ForProvider.Type != RecordTypeA {
+			return errors.Errorf(errLBRecordType, mg.Spec.ForProvider.Type)
+		}
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			Extract:   lbv1alpha1.LBIP(),
+			Reference: mg.Spec.ForProvider.DataLoadBalancerRef,
+			Selector:  mg.Spec.ForProvider.DataLoadBalancerSelector,
+			To: reference.To{
+				List:    &lbv1alpha1.LBList{},
+				Managed: &lbv1alpha1.LB{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Data")
+		}
+		mg.Spec.ForProvider.Data = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.DataLoadBalancerRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
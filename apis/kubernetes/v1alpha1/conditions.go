@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Condition types used by the resources of this API group.
+const (
+	// TypeLintWarnings indicates whether the most recent clusterlint run of a
+	// DOKubernetesCluster reported errors.
+	TypeLintWarnings xpv1.ConditionType = "LintWarnings"
+
+	// TypeOverQuota indicates whether the usage of a DOContainerRegistry
+	// exceeds what is included in its subscription tier.
+	TypeOverQuota xpv1.ConditionType = "OverQuota"
+
+	// TypeTierChangeBlocked indicates whether a change of the subscription
+	// tier of a DOContainerRegistry is blocked because the current usage
+	// exceeds the limits of the requested tier.
+	TypeTierChangeBlocked xpv1.ConditionType = "TierChangeBlocked"
+)
+
+// Condition reasons used by the resources of this API group.
+const (
+	ReasonClusterlintErrors   xpv1.ConditionReason = "ClusterlintErrors"
+	ReasonClusterlintClean    xpv1.ConditionReason = "ClusterlintClean"
+	ReasonClusterlintDisabled xpv1.ConditionReason = "ClusterlintDisabled"
+
+	ReasonUsageExceedsTier xpv1.ConditionReason = "UsageExceedsTier"
+	ReasonUsageWithinTier  xpv1.ConditionReason = "UsageWithinTier"
+
+	ReasonTierLimitsExceeded xpv1.ConditionReason = "TierLimitsExceeded"
+	ReasonTierChangeAllowed  xpv1.ConditionReason = "TierChangeAllowed"
+)
+
+// LintErrors returns a condition that indicates the most recent clusterlint
+// run reported the supplied number of errors.
+func LintErrors(count int) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeLintWarnings,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonClusterlintErrors,
+		Message:            fmt.Sprintf("clusterlint reported %d error(s)", count),
+	}
+}
+
+// LintClean returns a condition that indicates the most recent clusterlint
+// run did not report any errors.
+func LintClean() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeLintWarnings,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonClusterlintClean,
+	}
+}
+
+// LintDisabled returns a condition that indicates clusterlint is not run
+// against the cluster.
+func LintDisabled() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeLintWarnings,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonClusterlintDisabled,
+	}
+}
+
+// OverQuota returns a condition that indicates the usage of a registry exceeds
+// its subscription tier for the supplied reason.
+func OverQuota(message string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeOverQuota,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUsageExceedsTier,
+		Message:            message,
+	}
+}
+
+// WithinQuota returns a condition that indicates the usage of a registry is
+// included in its subscription tier.
+func WithinQuota() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeOverQuota,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUsageWithinTier,
+	}
+}
+
+// TierChangeBlocked returns a condition that indicates a change of the
+// subscription tier of a registry is blocked for the supplied reason.
+func TierChangeBlocked(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTierChangeBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTierLimitsExceeded,
+		Message:            err.Error(),
+	}
+}
+
+// TierChangeAllowed returns a condition that indicates the subscription tier
+// of a registry can be changed.
+func TierChangeAllowed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTierChangeBlocked,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTierChangeAllowed,
+	}
+}
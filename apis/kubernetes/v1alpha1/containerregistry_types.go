@@ -34,6 +34,157 @@ type DOContainerRegistryParameters struct {
 	// +immutable
 	// +kubebuilder:validation:Optional
 	Region *string `json:"region,omitempty"`
+
+	// An object specifying the Docker credentials that are published to the connection secret.
+	// The connection secret is of type kubernetes.io/dockerconfigjson and can be used as an imagePullSecret.
+	// +kubebuilder:validation:Optional
+	DockerCredentials *DockerCredentialsParameters `json:"dockerCredentials,omitempty"`
+
+	// An object specifying a schedule on which garbage collection is run for the registry.
+	// +kubebuilder:validation:Optional
+	GarbageCollection *GarbageCollectionPolicy `json:"garbageCollection,omitempty"`
+
+	// An object specifying which tags of the repositories in the registry are retained.
+	// Tags that are not retained are deleted periodically.
+	// +kubebuilder:validation:Optional
+	TagRetention *TagRetentionPolicy `json:"tagRetention,omitempty"`
+}
+
+// TagRetentionPolicy defines which tags of the repositories of a Container Registry are retained.
+// A tag is deleted when it is neither protected nor among the most recently updated tags to keep,
+// and, if a maximum age is set, is older than the maximum age.
+type TagRetentionPolicy struct {
+	// The number of most recently updated tags that are retained per repository.
+	// Protected tags do not count towards this number.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	KeepLast *int `json:"keepLast,omitempty"`
+
+	// The number of days after its last update after which a tag is deleted.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxAgeDays *int `json:"maxAgeDays,omitempty"`
+
+	// A list of regular expressions matching tags that are never deleted, e.g. "^latest$" or "^v[0-9]+".
+	// +kubebuilder:validation:Optional
+	ProtectedTags []string `json:"protectedTags,omitempty"`
+
+	// A boolean indicating whether tags that are not retained are only reported in the status
+	// instead of being deleted.
+	// +kubebuilder:validation:Optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// The interval in minutes at which the repositories of the registry are evaluated. Defaults to 60.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	IntervalMinutes *int `json:"intervalMinutes,omitempty"`
+}
+
+// TagRetentionObservation reflects the enforcement of the tag retention policy of a Container Registry.
+type TagRetentionObservation struct {
+	// The time at which the repositories of the registry were most recently evaluated.
+	// +kubebuilder:validation:Optional
+	LastEvaluatedAt *metav1.Time `json:"lastEvaluatedAt,omitempty"`
+
+	// The number of tags that are not retained by the policy as of the most recent evaluation.
+	// In dry-run mode these tags are only reported.
+	// +kubebuilder:validation:Optional
+	ExpiredTagCount int `json:"expiredTagCount,omitempty"`
+
+	// A sample of at most 20 of the tags, in the form repository:tag, that are not retained by the policy
+	// as of the most recent evaluation.
+	// +kubebuilder:validation:Optional
+	ExpiredTags []string `json:"expiredTags,omitempty"`
+
+	// The time at which tags were most recently deleted.
+	// +kubebuilder:validation:Optional
+	LastPrunedAt *metav1.Time `json:"lastPrunedAt,omitempty"`
+
+	// The number of tags deleted at the time tags were most recently deleted.
+	// +kubebuilder:validation:Optional
+	LastPrunedCount int `json:"lastPrunedCount,omitempty"`
+}
+
+// GarbageCollectionPolicy defines when garbage collection is run for a Container Registry.
+type GarbageCollectionPolicy struct {
+	// A cron expression in UTC specifying when garbage collection is started, e.g. "0 3 * * 0".
+	// A scheduled run is skipped while another garbage collection is still active.
+	Schedule string `json:"schedule"`
+
+	// A boolean indicating whether untagged manifests are deleted in addition to unreferenced blobs.
+	// +kubebuilder:validation:Optional
+	IncludeUntaggedManifests bool `json:"includeUntaggedManifests,omitempty"`
+}
+
+// GarbageCollectionObservation reflects the scheduled garbage collections of a Container Registry.
+type GarbageCollectionObservation struct {
+	// The time at which garbage collection was most recently scheduled.
+	// +kubebuilder:validation:Optional
+	LastScheduledAt *metav1.Time `json:"lastScheduledAt,omitempty"`
+
+	// The time at which garbage collection is scheduled next.
+	// +kubebuilder:validation:Optional
+	NextScheduledAt *metav1.Time `json:"nextScheduledAt,omitempty"`
+
+	// An object specifying the most recent garbage collection of the registry.
+	// +kubebuilder:validation:Optional
+	Latest *GarbageCollectionRun `json:"latest,omitempty"`
+}
+
+// GarbageCollectionRun reflects a single garbage collection of a Container Registry.
+type GarbageCollectionRun struct {
+	// A string specifying the UUID of the garbage collection.
+	UUID string `json:"uuid"`
+
+	// The current status of the garbage collection.
+	Status string `json:"status"`
+
+	// The type of the garbage collection.
+	Type string `json:"type,omitempty"`
+
+	// The time at which the garbage collection was created.
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	// The time at which the garbage collection was last updated.
+	UpdatedAt string `json:"updatedAt,omitempty"`
+
+	// The number of blobs deleted as a result of the garbage collection.
+	BlobsDeleted uint64 `json:"blobsDeleted,omitempty"`
+
+	// The number of bytes freed as a result of the garbage collection.
+	FreedBytes uint64 `json:"freedBytes,omitempty"`
+}
+
+// DockerCredentialsParameters define the Docker credentials issued for a Container Registry.
+type DockerCredentialsParameters struct {
+	// A boolean indicating whether the credentials grant push access to the registry.
+	// Credentials only grant pull access by default.
+	// +kubebuilder:validation:Optional
+	ReadWrite bool `json:"readWrite,omitempty"`
+
+	// The number of seconds after which the credentials expire. Credentials never expire when not provided.
+	// Expiring credentials are rotated once three quarters of their lifetime have elapsed.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=60
+	ExpirySeconds *int `json:"expirySeconds,omitempty"`
+}
+
+// DockerCredentialsObservation reflects the Docker credentials currently published for a Container Registry.
+type DockerCredentialsObservation struct {
+	// A boolean indicating whether the published credentials grant push access to the registry.
+	ReadWrite bool `json:"readWrite,omitempty"`
+
+	// The number of seconds after which the published credentials expire.
+	// +kubebuilder:validation:Optional
+	ExpirySeconds *int `json:"expirySeconds,omitempty"`
+
+	// The time at which the published credentials were issued.
+	// +kubebuilder:validation:Optional
+	IssuedAt *metav1.Time `json:"issuedAt,omitempty"`
+
+	// The time at which the published credentials expire.
+	// +kubebuilder:validation:Optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 }
 
 // The Tier defines a subscription tier for a Container Registry.
@@ -93,8 +244,28 @@ type DOContainerRegistryObservation struct {
 	// Storage usage is calculated asynchronously, and may not immediately reflect pushes to the registry.
 	StorageUsageBytesUpdatedAt string `json:"storageUsageBytesUpdatedAt"`
 
+	// The number of repositories in the registry.
+	// +kubebuilder:validation:Optional
+	RepositoryCount uint64 `json:"repositoryCount,omitempty"`
+
+	// The time at which the repositories of the registry were most recently counted.
+	// +kubebuilder:validation:Optional
+	RepositoryCountUpdatedAt *metav1.Time `json:"repositoryCountUpdatedAt,omitempty"`
+
 	// An object specifying the subscription for a Container Registry.
 	Subscription Subscription `json:"subscription"`
+
+	// An object specifying the Docker credentials currently published to the connection secret.
+	// +kubebuilder:validation:Optional
+	DockerCredentials *DockerCredentialsObservation `json:"dockerCredentials,omitempty"`
+
+	// An object specifying the scheduled garbage collections of the registry.
+	// +kubebuilder:validation:Optional
+	GarbageCollection *GarbageCollectionObservation `json:"garbageCollection,omitempty"`
+
+	// An object specifying the enforcement of the tag retention policy of the registry.
+	// +kubebuilder:validation:Optional
+	TagRetention *TagRetentionObservation `json:"tagRetention,omitempty"`
 }
 
 // A DOContainerRegistrySpec defines the desired state of a ContainerRegistry.
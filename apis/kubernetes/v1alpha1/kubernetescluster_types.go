@@ -46,13 +46,22 @@ type DOKubernetesClusterParameters struct {
 	// The slug identifier for the version of Kubernetes used for the cluster.
 	// If set to a minor version (e.g. "1.14"), the latest version within it will be used (e.g. "1.14.6-do.1");
 	// if set to "latest", the latest published version will be used. See the /v2/kubernetes/options endpoint
-	// to find all currently available versions.
+	// to find all currently available versions. The resolved version is reported in the status.
 	Version string `json:"version"`
 
 	// A string specifying the UUID of the VPC to which the Kubernetes cluster is assigned.
 	// +kubebuilder:validation:Optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-digitalocean/apis/vpc/v1alpha1.VPC
 	VPCUUID *string `json:"vpcuui,omitempty"`
 
+	// A reference to the VPC to which the Kubernetes cluster is assigned.
+	// +kubebuilder:validation:Optional
+	VPCUUIDRef *xpv1.Reference `json:"vpcUuidRef,omitempty"`
+
+	// Selects the VPC to which the Kubernetes cluster is assigned.
+	// +kubebuilder:validation:Optional
+	VPCUUIDSelector *xpv1.Selector `json:"vpcUuidSelector,omitempty"`
+
 	// An array of tags applied to the Kubernetes cluster. All clusters are automatically tagged k8s and k8s:$K8S_CLUSTER_ID.
 	// +kubebuilder:validation:Optional
 	Tags []string `json:"tags,omitempty"`
@@ -75,6 +84,11 @@ type DOKubernetesClusterParameters struct {
 	// A boolean value indicating whether the control plane is run in a highly available configuration in the cluster. Highly available control planes incur less downtime.
 	// +kubebuilder:validation:Optional
 	HighlyAvailable *bool `json:"highlyAvailable,omitempty"`
+
+	// An object specifying if and when clusterlint diagnostics are collected for the cluster.
+	// Clusterlint is run periodically and whenever a new upgrade becomes available for the cluster.
+	// +kubebuilder:validation:Optional
+	Clusterlint *KubernetesClusterlintPolicy `json:"clusterlint,omitempty"`
 }
 
 // DOKubernetesClusterObservation reflects the observed state of a KubernetesCluster on DigitalOcean.
@@ -89,10 +103,8 @@ type DOKubernetesClusterObservation struct {
 	// The slug identifier for the region where the Kubernetes cluster is located.
 	Region string `json:"region,omitempty"`
 
-	// The slug identifier for the version of Kubernetes used for the cluster.
-	// If set to a minor version (e.g. "1.14"), the latest version within it will be used (e.g. "1.14.6-do.1");
-	// if set to "latest", the latest published version will be used. See the /v2/kubernetes/options endpoint
-	// to find all currently available versions.
+	// The slug identifier for the version of Kubernetes used for the cluster, e.g. "1.14.6-do.1".
+	// Version aliases set in the parameters are resolved to this slug when the cluster is created.
 	Version string `json:"version,omitempty"`
 
 	// The range of IP addresses in the overlay network of the Kubernetes cluster in CIDR notation.
@@ -139,6 +151,9 @@ type DOKubernetesClusterObservation struct {
 
 	// A read-only boolean value indicating if a container registry is integrated with the cluster.
 	RegistryEnabled bool `json:"registryEnabled,omitempty"`
+
+	// An object containing the outcome of the most recent clusterlint run.
+	Clusterlint *KubernetesClusterlintObservation `json:"clusterlint,omitempty"`
 }
 
 // KubernetesNodePool represents a node pool that makes up a Kubernetes Cluster
@@ -175,6 +190,15 @@ type KubernetesNodePool struct {
 	// The maximum number of nodes that this node pool can be auto-scaled to. The value will be 0 if auto_scale is set to false.
 	// +kubebuilder:validation:Optional
 	MaxNodes int `json:"maxNodes,omitempty"`
+
+	// An array of node names that should be replaced. Listed nodes are deleted and replaced one at a time.
+	// +kubebuilder:validation:Optional
+	RecycleNodeNames []string `json:"recycleNodeNames,omitempty"`
+
+	// A counter that causes every node in the pool to be replaced in a rolling fashion whenever it is increased.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	RecycleGeneration int64 `json:"recycleGeneration,omitempty"`
 }
 
 // KubernetesNodePoolObservation represents the observed state of KubernetesNodePool
@@ -218,6 +242,20 @@ type KubernetesNodePoolObservation struct {
 
 	// An object specifying the details of a specific worker node in a node pool.
 	Nodes []KubernetesNode `json:"nodes,omitempty"`
+
+	// The recycle generation of the node pool that was most recently requested.
+	// +kubebuilder:validation:Optional
+	RecycleGeneration int64 `json:"recycleGeneration,omitempty"`
+
+	// The time at which the most recent recycle generation was requested.
+	// Nodes created before this time are replaced.
+	// +kubebuilder:validation:Optional
+	RecycleStartedAt *metav1.Time `json:"recycleStartedAt,omitempty"`
+
+	// The ID of the node that was most recently deleted to be replaced. No
+	// other node of the pool is replaced until it is gone.
+	// +kubebuilder:validation:Optional
+	RecyclingNodeID string `json:"recyclingNodeID,omitempty"`
 }
 
 // KubernetesNodePoolTaint represents a Kubernetes Node Pool Taint.
@@ -265,6 +303,10 @@ type KubernetesNode struct {
 	// A time value given in ISO8601 combined date and time format that represents when the node was last updated.
 	// +kubebuilder:validation:Optional
 	UpdatedAt string `json:"updatedAt,omitempty"`
+
+	// A boolean value indicating whether the node is waiting to be replaced.
+	// +kubebuilder:validation:Optional
+	PendingRecycle bool `json:"pendingRecycle,omitempty"`
 }
 
 // KubernetesClusterMaintenancePolicy represents a Maintenance Policy to be applied to a Kubernetes Cluster on DigitalOcean
@@ -288,6 +330,81 @@ type KubernetesClusterMaintenancePolicyObservation struct {
 	Duration string `json:"duration,omitempty"`
 }
 
+// KubernetesClusterlintPolicy configures the clusterlint runs of a Kubernetes Cluster.
+// See docs https://docs.digitalocean.com/reference/api/api-reference/#operation/run_clusterLint
+type KubernetesClusterlintPolicy struct {
+	// A boolean value indicating whether clusterlint should be run against the cluster.
+	Enabled bool `json:"enabled"`
+
+	// The number of minutes to wait between two clusterlint runs. Defaults to 1440 (one day).
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	IntervalMinutes *int `json:"intervalMinutes,omitempty"`
+
+	// An array of check groups that will be run when clusterlint executes checks.
+	// +kubebuilder:validation:Optional
+	IncludeGroups []string `json:"includeGroups,omitempty"`
+
+	// An array of check groups that will be omitted when clusterlint executes checks.
+	// +kubebuilder:validation:Optional
+	ExcludeGroups []string `json:"excludeGroups,omitempty"`
+
+	// An array of checks that will be run when clusterlint executes checks.
+	// +kubebuilder:validation:Optional
+	IncludeChecks []string `json:"includeChecks,omitempty"`
+
+	// An array of checks that will be omitted when clusterlint executes checks.
+	// +kubebuilder:validation:Optional
+	ExcludeChecks []string `json:"excludeChecks,omitempty"`
+}
+
+// KubernetesClusterlintObservation is the observed state of the clusterlint runs of a Kubernetes Cluster.
+type KubernetesClusterlintObservation struct {
+	// The ID of the most recent clusterlint run.
+	RunID string `json:"runID,omitempty"`
+
+	// The time at which the most recent clusterlint run was requested.
+	// +kubebuilder:validation:Optional
+	RequestedAt *metav1.Time `json:"requestedAt,omitempty"`
+
+	// The time at which the results of the most recent clusterlint run were collected.
+	// +kubebuilder:validation:Optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// The slug of the upgrade version that was available when the most recent clusterlint run was requested.
+	// +kubebuilder:validation:Optional
+	UpgradeVersion string `json:"upgradeVersion,omitempty"`
+
+	// An array of diagnostics reported by the most recent clusterlint run.
+	// +kubebuilder:validation:Optional
+	Diagnostics []KubernetesClusterlintDiagnostic `json:"diagnostics,omitempty"`
+}
+
+// KubernetesClusterlintDiagnostic is a single diagnostic reported by clusterlint.
+type KubernetesClusterlintDiagnostic struct {
+	// The name of the check that reported the diagnostic.
+	CheckName string `json:"checkName"`
+
+	// The severity of the diagnostic. May be one of error, warning or suggestion.
+	Severity string `json:"severity"`
+
+	// A message describing the diagnostic.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+
+	// The kind of the Kubernetes object the diagnostic refers to.
+	// +kubebuilder:validation:Optional
+	ObjectKind string `json:"objectKind,omitempty"`
+
+	// The name of the Kubernetes object the diagnostic refers to.
+	// +kubebuilder:validation:Optional
+	ObjectName string `json:"objectName,omitempty"`
+
+	// The namespace of the Kubernetes object the diagnostic refers to.
+	// +kubebuilder:validation:Optional
+	ObjectNamespace string `json:"objectNamespace,omitempty"`
+}
+
 // KubernetesStatus represents the status of a Kubernetes Cluster
 type KubernetesStatus struct {
 	// A string indicating the current status of the node.
@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -87,7 +88,26 @@ func (in *DOContainerRegistryList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DOContainerRegistryObservation) DeepCopyInto(out *DOContainerRegistryObservation) {
 	*out = *in
+	if in.RepositoryCountUpdatedAt != nil {
+		in, out := &in.RepositoryCountUpdatedAt, &out.RepositoryCountUpdatedAt
+		*out = (*in).DeepCopy()
+	}
 	out.Subscription = in.Subscription
+	if in.DockerCredentials != nil {
+		in, out := &in.DockerCredentials, &out.DockerCredentials
+		*out = new(DockerCredentialsObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(GarbageCollectionObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TagRetention != nil {
+		in, out := &in.TagRetention, &out.TagRetention
+		*out = new(TagRetentionObservation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOContainerRegistryObservation.
@@ -108,6 +128,21 @@ func (in *DOContainerRegistryParameters) DeepCopyInto(out *DOContainerRegistryPa
 		*out = new(string)
 		**out = **in
 	}
+	if in.DockerCredentials != nil {
+		in, out := &in.DockerCredentials, &out.DockerCredentials
+		*out = new(DockerCredentialsParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(GarbageCollectionPolicy)
+		**out = **in
+	}
+	if in.TagRetention != nil {
+		in, out := &in.TagRetention, &out.TagRetention
+		*out = new(TagRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOContainerRegistryParameters.
@@ -141,7 +176,7 @@ func (in *DOContainerRegistrySpec) DeepCopy() *DOContainerRegistrySpec {
 func (in *DOContainerRegistryStatus) DeepCopyInto(out *DOContainerRegistryStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOContainerRegistryStatus.
@@ -230,6 +265,11 @@ func (in *DOKubernetesClusterObservation) DeepCopyInto(out *DOKubernetesClusterO
 	}
 	out.MaintenancePolicy = in.MaintenancePolicy
 	out.Status = in.Status
+	if in.Clusterlint != nil {
+		in, out := &in.Clusterlint, &out.Clusterlint
+		*out = new(KubernetesClusterlintObservation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOKubernetesClusterObservation.
@@ -250,6 +290,16 @@ func (in *DOKubernetesClusterParameters) DeepCopyInto(out *DOKubernetesClusterPa
 		*out = new(string)
 		**out = **in
 	}
+	if in.VPCUUIDRef != nil {
+		in, out := &in.VPCUUIDRef, &out.VPCUUIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VPCUUIDSelector != nil {
+		in, out := &in.VPCUUIDSelector, &out.VPCUUIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]string, len(*in))
@@ -282,6 +332,11 @@ func (in *DOKubernetesClusterParameters) DeepCopyInto(out *DOKubernetesClusterPa
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Clusterlint != nil {
+		in, out := &in.Clusterlint, &out.Clusterlint
+		*out = new(KubernetesClusterlintPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOKubernetesClusterParameters.
@@ -328,6 +383,112 @@ func (in *DOKubernetesClusterStatus) DeepCopy() *DOKubernetesClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerCredentialsObservation) DeepCopyInto(out *DockerCredentialsObservation) {
+	*out = *in
+	if in.ExpirySeconds != nil {
+		in, out := &in.ExpirySeconds, &out.ExpirySeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.IssuedAt != nil {
+		in, out := &in.IssuedAt, &out.IssuedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerCredentialsObservation.
+func (in *DockerCredentialsObservation) DeepCopy() *DockerCredentialsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerCredentialsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerCredentialsParameters) DeepCopyInto(out *DockerCredentialsParameters) {
+	*out = *in
+	if in.ExpirySeconds != nil {
+		in, out := &in.ExpirySeconds, &out.ExpirySeconds
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerCredentialsParameters.
+func (in *DockerCredentialsParameters) DeepCopy() *DockerCredentialsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerCredentialsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollectionObservation) DeepCopyInto(out *GarbageCollectionObservation) {
+	*out = *in
+	if in.LastScheduledAt != nil {
+		in, out := &in.LastScheduledAt, &out.LastScheduledAt
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduledAt != nil {
+		in, out := &in.NextScheduledAt, &out.NextScheduledAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Latest != nil {
+		in, out := &in.Latest, &out.Latest
+		*out = new(GarbageCollectionRun)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionObservation.
+func (in *GarbageCollectionObservation) DeepCopy() *GarbageCollectionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GarbageCollectionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollectionPolicy) DeepCopyInto(out *GarbageCollectionPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionPolicy.
+func (in *GarbageCollectionPolicy) DeepCopy() *GarbageCollectionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GarbageCollectionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollectionRun) DeepCopyInto(out *GarbageCollectionRun) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionRun.
+func (in *GarbageCollectionRun) DeepCopy() *GarbageCollectionRun {
+	if in == nil {
+		return nil
+	}
+	out := new(GarbageCollectionRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesClusterMaintenancePolicy) DeepCopyInto(out *KubernetesClusterMaintenancePolicy) {
 	*out = *in
@@ -359,6 +520,89 @@ func (in *KubernetesClusterMaintenancePolicyObservation) DeepCopy() *KubernetesC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterlintDiagnostic) DeepCopyInto(out *KubernetesClusterlintDiagnostic) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterlintDiagnostic.
+func (in *KubernetesClusterlintDiagnostic) DeepCopy() *KubernetesClusterlintDiagnostic {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterlintDiagnostic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterlintObservation) DeepCopyInto(out *KubernetesClusterlintObservation) {
+	*out = *in
+	if in.RequestedAt != nil {
+		in, out := &in.RequestedAt, &out.RequestedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Diagnostics != nil {
+		in, out := &in.Diagnostics, &out.Diagnostics
+		*out = make([]KubernetesClusterlintDiagnostic, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterlintObservation.
+func (in *KubernetesClusterlintObservation) DeepCopy() *KubernetesClusterlintObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterlintObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterlintPolicy) DeepCopyInto(out *KubernetesClusterlintPolicy) {
+	*out = *in
+	if in.IntervalMinutes != nil {
+		in, out := &in.IntervalMinutes, &out.IntervalMinutes
+		*out = new(int)
+		**out = **in
+	}
+	if in.IncludeGroups != nil {
+		in, out := &in.IncludeGroups, &out.IncludeGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeGroups != nil {
+		in, out := &in.ExcludeGroups, &out.ExcludeGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludeChecks != nil {
+		in, out := &in.IncludeChecks, &out.IncludeChecks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeChecks != nil {
+		in, out := &in.ExcludeChecks, &out.ExcludeChecks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterlintPolicy.
+func (in *KubernetesClusterlintPolicy) DeepCopy() *KubernetesClusterlintPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterlintPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesNode) DeepCopyInto(out *KubernetesNode) {
 	*out = *in
@@ -395,6 +639,11 @@ func (in *KubernetesNodePool) DeepCopyInto(out *KubernetesNodePool) {
 		*out = make([]KubernetesNodePoolTaint, len(*in))
 		copy(*out, *in)
 	}
+	if in.RecycleNodeNames != nil {
+		in, out := &in.RecycleNodeNames, &out.RecycleNodeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesNodePool.
@@ -432,6 +681,10 @@ func (in *KubernetesNodePoolObservation) DeepCopyInto(out *KubernetesNodePoolObs
 		*out = make([]KubernetesNode, len(*in))
 		copy(*out, *in)
 	}
+	if in.RecycleStartedAt != nil {
+		in, out := &in.RecycleStartedAt, &out.RecycleStartedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesNodePoolObservation.
@@ -490,6 +743,69 @@ func (in *Subscription) DeepCopy() *Subscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagRetentionObservation) DeepCopyInto(out *TagRetentionObservation) {
+	*out = *in
+	if in.LastEvaluatedAt != nil {
+		in, out := &in.LastEvaluatedAt, &out.LastEvaluatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiredTags != nil {
+		in, out := &in.ExpiredTags, &out.ExpiredTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastPrunedAt != nil {
+		in, out := &in.LastPrunedAt, &out.LastPrunedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagRetentionObservation.
+func (in *TagRetentionObservation) DeepCopy() *TagRetentionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TagRetentionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagRetentionPolicy) DeepCopyInto(out *TagRetentionPolicy) {
+	*out = *in
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxAgeDays != nil {
+		in, out := &in.MaxAgeDays, &out.MaxAgeDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.ProtectedTags != nil {
+		in, out := &in.ProtectedTags, &out.ProtectedTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IntervalMinutes != nil {
+		in, out := &in.IntervalMinutes, &out.IntervalMinutes
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagRetentionPolicy.
+func (in *TagRetentionPolicy) DeepCopy() *TagRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TagRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tier) DeepCopyInto(out *Tier) {
 	*out = *in
@@ -65,7 +65,16 @@ type LBParameters struct {
 	// will be assigned to your account's default VPC for the region.
 	// +optional
 	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-digitalocean/apis/vpc/v1alpha1.VPC
 	VPCUUID *string `json:"vpc_uuid,omitempty"`
+
+	// VPCUUIDRef: A reference to the VPC to which the LB will be assigned.
+	// +optional
+	VPCUUIDRef *xpv1.Reference `json:"vpcUuidRef,omitempty"`
+
+	// VPCUUIDSelector: Selects the VPC to which the LB will be assigned.
+	// +optional
+	VPCUUIDSelector *xpv1.Selector `json:"vpcUuidSelector,omitempty"`
 }
 
 // DOLoadBalancerHealthCheck define the DigitalOcean loadbalancers health check configurations.
@@ -104,8 +113,8 @@ type LBObservation struct {
 	// ID for the resource. This identifier is defined by the server.
 	ID string `json:"id,omitempty"`
 
-	// IP for the resource.
-	IP int `json:"ip,omitempty"`
+	// Public IPv4 address of the resource.
+	IP string `json:"ip,omitempty"`
 
 	// A Status string indicating the state of the LB instance.
 	//
@@ -0,0 +1,60 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Condition types shared by the managed resources of all API groups.
+const (
+	// TypeInvalidParameters indicates whether the parameters of a resource
+	// were rejected before they were sent to DigitalOcean.
+	TypeInvalidParameters xpv1.ConditionType = "InvalidParameters"
+)
+
+// Condition reasons shared by the managed resources of all API groups.
+const (
+	ReasonParametersRejected xpv1.ConditionReason = "ParametersRejected"
+	ReasonParametersAccepted xpv1.ConditionReason = "ParametersAccepted"
+)
+
+// ParametersRejected returns a condition that indicates the parameters of a
+// resource were rejected for the supplied reason.
+func ParametersRejected(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeInvalidParameters,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonParametersRejected,
+		Message:            err.Error(),
+	}
+}
+
+// ParametersAccepted returns a condition that indicates the parameters of a
+// resource are valid.
+func ParametersAccepted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeInvalidParameters,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonParametersAccepted,
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Condition types used by the resources of this API group.
+const (
+	// TypeDeletionBlocked indicates whether the deletion of a resource is
+	// blocked by DigitalOcean resources that still depend on it.
+	TypeDeletionBlocked xpv1.ConditionType = "DeletionBlocked"
+)
+
+// Condition reasons used by the resources of this API group.
+const (
+	ReasonHasMembers xpv1.ConditionReason = "HasMembers"
+)
+
+// HasMembers returns a condition that indicates a VPC cannot be deleted
+// because resources are still assigned to it.
+func HasMembers(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionBlocked,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonHasMembers,
+		Message:            msg,
+	}
+}
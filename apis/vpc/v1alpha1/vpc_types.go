@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// VPCParameters define the desired state of a DigitalOcean VPC.
+// Most fields map directly to a VPC:
+// https://docs.digitalocean.com/reference/api/api-reference/#tag/VPCs
+type VPCParameters struct {
+	// Name: A unique name for the VPC. It may only contain alphanumeric
+	// characters, dashes, and periods. Defaults to the name of the managed
+	// resource.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9\-\.]+$`
+	Name *string `json:"name,omitempty"`
+
+	// Region: The slug identifier for the region where the VPC will be
+	// created.
+	// +immutable
+	Region string `json:"region"`
+
+	// IPRange: The range of IP addresses in the VPC in CIDR notation. It
+	// must not overlap with other VPCs of the account. It is chosen by
+	// DigitalOcean when omitted.
+	// +optional
+	// +immutable
+	IPRange *string `json:"ipRange,omitempty"`
+
+	// Description: A free-form text field for describing the VPC.
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	Description *string `json:"description,omitempty"`
+
+	// Default: Whether the VPC is the default VPC of its region. Setting it
+	// makes the VPC the default one, which cannot be undone other than by
+	// making another VPC of the region the default.
+	// +optional
+	Default *bool `json:"default,omitempty"`
+}
+
+// A VPCObservation reflects the observed state of a VPC on DigitalOcean.
+type VPCObservation struct {
+	// ID for the resource. This identifier is defined by the server.
+	ID string `json:"id,omitempty"`
+
+	// URN of the VPC.
+	URN string `json:"urn,omitempty"`
+
+	// Name of the VPC.
+	Name string `json:"name,omitempty"`
+
+	// Resource region slug.
+	Region string `json:"region,omitempty"`
+
+	// Range of IP addresses of the VPC in CIDR notation.
+	IPRange string `json:"ipRange,omitempty"`
+
+	// Description of the VPC.
+	Description string `json:"description,omitempty"`
+
+	// Whether the VPC is the default VPC of its region.
+	Default bool `json:"default,omitempty"`
+
+	// CreatedAt in RFC3339 text format.
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+// A VPCSpec defines the desired state of a VPC.
+type VPCSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPCParameters `json:"forProvider"`
+}
+
+// A VPCStatus represents the observed state of a VPC.
+type VPCStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VPCObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPC is a managed resource that represents a DigitalOcean VPC.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".status.atProvider.region"
+// +kubebuilder:printcolumn:name="IP-RANGE",type="string",JSONPath=".status.atProvider.ipRange"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,do}
+type VPC struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCSpec   `json:"spec"`
+	Status VPCStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCList contains a list of VPC.
+type VPCList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPC `json:"items"`
+}
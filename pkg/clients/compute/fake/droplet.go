@@ -0,0 +1,53 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+)
+
+// this ensures that the mocks implement the client interfaces
+var (
+	_ godo.DropletsService       = (*MockDropletsService)(nil)
+	_ godo.DropletActionsService = (*MockDropletActionsService)(nil)
+)
+
+// MockDropletsService is a type that implements the methods of
+// godo.DropletsService used by the controllers of the compute API group.
+// Calling any other method panics.
+type MockDropletsService struct {
+	godo.DropletsService
+
+	MockListByTag func(context.Context, string, *godo.ListOptions) ([]godo.Droplet, *godo.Response, error)
+	MockSnapshots func(context.Context, int, *godo.ListOptions) ([]godo.Image, *godo.Response, error)
+}
+
+// ListByTag mocks ListByTag method
+func (c *MockDropletsService) ListByTag(ctx context.Context, tag string, opt *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+	return c.MockListByTag(ctx, tag, opt)
+}
+
+// Snapshots mocks Snapshots method
+func (c *MockDropletsService) Snapshots(ctx context.Context, dropletID int, opt *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+	return c.MockSnapshots(ctx, dropletID, opt)
+}
+
+// MockDropletActionsService is a type that implements the methods of
+// godo.DropletActionsService used by the controllers of the compute API
+// group. Calling any other method panics.
+type MockDropletActionsService struct {
+	godo.DropletActionsService
+
+	MockGet      func(context.Context, int, int) (*godo.Action, *godo.Response, error)
+	MockSnapshot func(context.Context, int, string) (*godo.Action, *godo.Response, error)
+}
+
+// Get mocks Get method
+func (c *MockDropletActionsService) Get(ctx context.Context, dropletID, actionID int) (*godo.Action, *godo.Response, error) {
+	return c.MockGet(ctx, dropletID, actionID)
+}
+
+// Snapshot mocks Snapshot method
+func (c *MockDropletActionsService) Snapshot(ctx context.Context, dropletID int, name string) (*godo.Action, *godo.Response, error) {
+	return c.MockSnapshot(ctx, dropletID, name)
+}
@@ -0,0 +1,42 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+)
+
+// this ensures that the mock implements the client interface
+var _ godo.FirewallsService = (*MockFirewallsService)(nil)
+
+// MockFirewallsService is a type that implements the methods of
+// godo.FirewallsService used by the controllers of the compute API group.
+// Calling any other method panics.
+type MockFirewallsService struct {
+	godo.FirewallsService
+
+	MockGet    func(context.Context, string) (*godo.Firewall, *godo.Response, error)
+	MockCreate func(context.Context, *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error)
+	MockUpdate func(context.Context, string, *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error)
+	MockDelete func(context.Context, string) (*godo.Response, error)
+}
+
+// Get mocks Get method
+func (c *MockFirewallsService) Get(ctx context.Context, id string) (*godo.Firewall, *godo.Response, error) {
+	return c.MockGet(ctx, id)
+}
+
+// Create mocks Create method
+func (c *MockFirewallsService) Create(ctx context.Context, create *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error) {
+	return c.MockCreate(ctx, create)
+}
+
+// Update mocks Update method
+func (c *MockFirewallsService) Update(ctx context.Context, id string, update *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error) {
+	return c.MockUpdate(ctx, id, update)
+}
+
+// Delete mocks Delete method
+func (c *MockFirewallsService) Delete(ctx context.Context, id string) (*godo.Response, error) {
+	return c.MockDelete(ctx, id)
+}
@@ -0,0 +1,30 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+)
+
+// this ensures that the mock implements the client interface
+var _ godo.SnapshotsService = (*MockSnapshotsService)(nil)
+
+// MockSnapshotsService is a type that implements the methods of
+// godo.SnapshotsService used by the controllers of the compute API group.
+// Calling any other method panics.
+type MockSnapshotsService struct {
+	godo.SnapshotsService
+
+	MockGet    func(context.Context, string) (*godo.Snapshot, *godo.Response, error)
+	MockDelete func(context.Context, string) (*godo.Response, error)
+}
+
+// Get mocks Get method
+func (c *MockSnapshotsService) Get(ctx context.Context, id string) (*godo.Snapshot, *godo.Response, error) {
+	return c.MockGet(ctx, id)
+}
+
+// Delete mocks Delete method
+func (c *MockSnapshotsService) Delete(ctx context.Context, id string) (*godo.Response, error) {
+	return c.MockDelete(ctx, id)
+}
@@ -0,0 +1,71 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+)
+
+// this ensures that the mocks implement the client interfaces
+var (
+	_ godo.StorageService        = (*MockStorageService)(nil)
+	_ godo.StorageActionsService = (*MockStorageActionsService)(nil)
+)
+
+// MockStorageService is a type that implements the methods of
+// godo.StorageService used by the controllers of the compute API group.
+// Calling any other method panics.
+type MockStorageService struct {
+	godo.StorageService
+
+	MockGetVolume    func(context.Context, string) (*godo.Volume, *godo.Response, error)
+	MockCreateVolume func(context.Context, *godo.VolumeCreateRequest) (*godo.Volume, *godo.Response, error)
+	MockDeleteVolume func(context.Context, string) (*godo.Response, error)
+}
+
+// GetVolume mocks GetVolume method
+func (c *MockStorageService) GetVolume(ctx context.Context, id string) (*godo.Volume, *godo.Response, error) {
+	return c.MockGetVolume(ctx, id)
+}
+
+// CreateVolume mocks CreateVolume method
+func (c *MockStorageService) CreateVolume(ctx context.Context, create *godo.VolumeCreateRequest) (*godo.Volume, *godo.Response, error) {
+	return c.MockCreateVolume(ctx, create)
+}
+
+// DeleteVolume mocks DeleteVolume method
+func (c *MockStorageService) DeleteVolume(ctx context.Context, id string) (*godo.Response, error) {
+	return c.MockDeleteVolume(ctx, id)
+}
+
+// MockStorageActionsService is a type that implements the methods of
+// godo.StorageActionsService used by the controllers of the compute API
+// group. Calling any other method panics.
+type MockStorageActionsService struct {
+	godo.StorageActionsService
+
+	MockAttach            func(context.Context, string, int) (*godo.Action, *godo.Response, error)
+	MockDetachByDropletID func(context.Context, string, int) (*godo.Action, *godo.Response, error)
+	MockGet               func(context.Context, string, int) (*godo.Action, *godo.Response, error)
+	MockResize            func(context.Context, string, int, string) (*godo.Action, *godo.Response, error)
+}
+
+// Attach mocks Attach method
+func (c *MockStorageActionsService) Attach(ctx context.Context, volumeID string, dropletID int) (*godo.Action, *godo.Response, error) {
+	return c.MockAttach(ctx, volumeID, dropletID)
+}
+
+// DetachByDropletID mocks DetachByDropletID method
+func (c *MockStorageActionsService) DetachByDropletID(ctx context.Context, volumeID string, dropletID int) (*godo.Action, *godo.Response, error) {
+	return c.MockDetachByDropletID(ctx, volumeID, dropletID)
+}
+
+// Get mocks Get method
+func (c *MockStorageActionsService) Get(ctx context.Context, volumeID string, actionID int) (*godo.Action, *godo.Response, error) {
+	return c.MockGet(ctx, volumeID, actionID)
+}
+
+// Resize mocks Resize method
+func (c *MockStorageActionsService) Resize(ctx context.Context, volumeID string, sizeGigabytes int, regionSlug string) (*godo.Action, *godo.Response, error) {
+	return c.MockResize(ctx, volumeID, sizeGigabytes, regionSlug)
+}
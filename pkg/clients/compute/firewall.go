@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+)
+
+// GenerateFirewall generates *godo.FirewallRequest instance from
+// FirewallParameters. It returns an error if a Droplet ID is invalid.
+func GenerateFirewall(name string, in v1alpha1.FirewallParameters) (*godo.FirewallRequest, error) {
+	dropletIDs, err := parseDropletIDs(in.DropletIDs)
+	if err != nil {
+		return nil, err
+	}
+	req := &godo.FirewallRequest{
+		Name:          name,
+		InboundRules:  make([]godo.InboundRule, len(in.InboundRules)),
+		OutboundRules: make([]godo.OutboundRule, len(in.OutboundRules)),
+		DropletIDs:    dropletIDs,
+		Tags:          in.Tags,
+	}
+	for i, r := range in.InboundRules {
+		s, err := generateFirewallTargets(r.Sources)
+		if err != nil {
+			return nil, err
+		}
+		req.InboundRules[i] = godo.InboundRule{
+			Protocol:  r.Protocol,
+			PortRange: do.StringValue(r.PortRange),
+			Sources:   (*godo.Sources)(s),
+		}
+	}
+	for i, r := range in.OutboundRules {
+		d, err := generateFirewallTargets(r.Destinations)
+		if err != nil {
+			return nil, err
+		}
+		req.OutboundRules[i] = godo.OutboundRule{
+			Protocol:     r.Protocol,
+			PortRange:    do.StringValue(r.PortRange),
+			Destinations: d,
+		}
+	}
+	return req, nil
+}
+
+func generateFirewallTargets(in v1alpha1.FirewallTargets) (*godo.Destinations, error) {
+	dropletIDs, err := parseDropletIDs(in.DropletIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &godo.Destinations{
+		Addresses:        in.Addresses,
+		Tags:             in.Tags,
+		DropletIDs:       dropletIDs,
+		LoadBalancerUIDs: in.LoadBalancerUIDs,
+		KubernetesIDs:    in.KubernetesIDs,
+	}, nil
+}
+
+func parseDropletIDs(in []string) ([]int, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	ids := make([]int, len(in))
+	for i := range in {
+		id, err := ParseDropletID(&in[i])
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// GenerateFirewallObservation generates a FirewallObservation from the
+// supplied godo.Firewall.
+func GenerateFirewallObservation(observed godo.Firewall) v1alpha1.FirewallObservation {
+	o := v1alpha1.FirewallObservation{
+		ID:         observed.ID,
+		Name:       observed.Name,
+		Status:     observed.Status,
+		CreatedAt:  observed.Created,
+		DropletIDs: observed.DropletIDs,
+		Tags:       observed.Tags,
+	}
+	for _, c := range observed.PendingChanges {
+		o.PendingChanges = append(o.PendingChanges, v1alpha1.FirewallPendingChange{
+			DropletID: c.DropletID,
+			Removing:  c.Removing,
+			Status:    c.Status,
+		})
+	}
+	return o
+}
+
+// FirewallIsUpToDate returns true if the supplied observed Firewall matches
+// the supplied desired Firewall, regardless of the order of its rules,
+// Droplets, tags and targets.
+func FirewallIsUpToDate(desired *godo.FirewallRequest, observed godo.Firewall) bool {
+	if desired.Name != observed.Name ||
+		!sameStrings(intStrings(desired.DropletIDs), intStrings(observed.DropletIDs)) ||
+		!sameStrings(desired.Tags, observed.Tags) {
+		return false
+	}
+
+	want := make([]string, 0, len(desired.InboundRules)+len(desired.OutboundRules))
+	for _, r := range desired.InboundRules {
+		want = append(want, ruleKey("in", r.Protocol, r.PortRange, (*godo.Destinations)(r.Sources)))
+	}
+	for _, r := range desired.OutboundRules {
+		want = append(want, ruleKey("out", r.Protocol, r.PortRange, r.Destinations))
+	}
+	got := make([]string, 0, len(observed.InboundRules)+len(observed.OutboundRules))
+	for _, r := range observed.InboundRules {
+		got = append(got, ruleKey("in", r.Protocol, r.PortRange, (*godo.Destinations)(r.Sources)))
+	}
+	for _, r := range observed.OutboundRules {
+		got = append(got, ruleKey("out", r.Protocol, r.PortRange, r.Destinations))
+	}
+	return sameStrings(want, got)
+}
+
+// ruleKey returns a string that identifies a Firewall rule regardless of the
+// order of its targets.
+func ruleKey(direction, protocol, ports string, t *godo.Destinations) string {
+	// DigitalOcean reports all ports as "0", which may be requested as "all"
+	// or omitted for icmp.
+	if ports == "" || ports == "all" {
+		ports = "0"
+	}
+	if t == nil {
+		t = &godo.Destinations{}
+	}
+	return strings.Join([]string{
+		direction, protocol, ports,
+		sortedJoin(t.Addresses),
+		sortedJoin(t.Tags),
+		sortedJoin(intStrings(t.DropletIDs)),
+		sortedJoin(t.LoadBalancerUIDs),
+		sortedJoin(t.KubernetesIDs),
+	}, "|")
+}
+
+func intStrings(in []int) []string {
+	out := make([]string, len(in))
+	for i, v := range in {
+		out[i] = strconv.Itoa(v)
+	}
+	return out
+}
+
+func sortedJoin(in []string) string {
+	s := append([]string(nil), in...)
+	sort.Strings(s)
+	return strings.Join(s, ",")
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := append([]string(nil), a...)
+	sb := append([]string(nil), b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+)
+
+func TestGenerateFirewall(t *testing.T) {
+	ssh := "22"
+	all := "all"
+	tests := map[string]struct {
+		params  v1alpha1.FirewallParameters
+		want    *godo.FirewallRequest
+		wantErr bool
+	}{
+		"Rules": {
+			params: v1alpha1.FirewallParameters{
+				InboundRules: []v1alpha1.FirewallInboundRule{{
+					Protocol:  "tcp",
+					PortRange: &ssh,
+					Sources:   v1alpha1.FirewallTargets{Addresses: []string{"192.0.2.0/24"}, LoadBalancerUIDs: []string{"mock-lb"}},
+				}},
+				OutboundRules: []v1alpha1.FirewallOutboundRule{{
+					Protocol:     "udp",
+					PortRange:    &all,
+					Destinations: v1alpha1.FirewallTargets{DropletIDs: []string{"42"}},
+				}},
+				DropletIDs: []string{"1", "2"},
+				Tags:       tags,
+			},
+			want: &godo.FirewallRequest{
+				Name: "mock-firewall",
+				InboundRules: []godo.InboundRule{{
+					Protocol:  "tcp",
+					PortRange: ssh,
+					Sources:   &godo.Sources{Addresses: []string{"192.0.2.0/24"}, LoadBalancerUIDs: []string{"mock-lb"}},
+				}},
+				OutboundRules: []godo.OutboundRule{{
+					Protocol:     "udp",
+					PortRange:    all,
+					Destinations: &godo.Destinations{DropletIDs: []int{42}},
+				}},
+				DropletIDs: []int{1, 2},
+				Tags:       tags,
+			},
+		},
+		"InvalidDropletID": {
+			params:  v1alpha1.FirewallParameters{DropletIDs: []string{"mock-droplet"}},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateFirewall("mock-firewall", tc.params)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFirewallIsUpToDate(t *testing.T) {
+	desired := &godo.FirewallRequest{
+		Name: "mock-firewall",
+		InboundRules: []godo.InboundRule{
+			{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: []string{"192.0.2.1", "192.0.2.2"}}},
+			{Protocol: "icmp", Sources: &godo.Sources{Tags: []string{"web"}}},
+		},
+		OutboundRules: []godo.OutboundRule{
+			{Protocol: "tcp", PortRange: "all", Destinations: &godo.Destinations{Addresses: []string{"0.0.0.0/0"}}},
+		},
+		DropletIDs: []int{1, 2},
+	}
+	tests := map[string]struct {
+		observed godo.Firewall
+		want     bool
+	}{
+		"UpToDate": {
+			observed: godo.Firewall{
+				Name: "mock-firewall",
+				InboundRules: []godo.InboundRule{
+					{Protocol: "icmp", PortRange: "0", Sources: &godo.Sources{Tags: []string{"web"}}},
+					{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: []string{"192.0.2.2", "192.0.2.1"}}},
+				},
+				OutboundRules: []godo.OutboundRule{
+					{Protocol: "tcp", PortRange: "0", Destinations: &godo.Destinations{Addresses: []string{"0.0.0.0/0"}}},
+				},
+				DropletIDs: []int{2, 1},
+			},
+			want: true,
+		},
+		"RuleChanged": {
+			observed: godo.Firewall{
+				Name: "mock-firewall",
+				InboundRules: []godo.InboundRule{
+					{Protocol: "icmp", PortRange: "0", Sources: &godo.Sources{Tags: []string{"web"}}},
+					{Protocol: "tcp", PortRange: "2222", Sources: &godo.Sources{Addresses: []string{"192.0.2.2", "192.0.2.1"}}},
+				},
+				OutboundRules: []godo.OutboundRule{
+					{Protocol: "tcp", PortRange: "0", Destinations: &godo.Destinations{Addresses: []string{"0.0.0.0/0"}}},
+				},
+				DropletIDs: []int{1, 2},
+			},
+			want: false,
+		},
+		"DropletsChanged": {
+			observed: godo.Firewall{
+				Name: "mock-firewall",
+				InboundRules: []godo.InboundRule{
+					{Protocol: "icmp", PortRange: "0", Sources: &godo.Sources{Tags: []string{"web"}}},
+					{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: []string{"192.0.2.2", "192.0.2.1"}}},
+				},
+				OutboundRules: []godo.OutboundRule{
+					{Protocol: "tcp", PortRange: "0", Destinations: &godo.Destinations{Addresses: []string{"0.0.0.0/0"}}},
+				},
+				DropletIDs: []int{1},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, FirewallIsUpToDate(desired, tc.observed))
+		})
+	}
+}
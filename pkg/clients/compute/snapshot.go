@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"strconv"
+
+	"github.com/digitalocean/godo"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+)
+
+const (
+	// ActionTypeSnapshot is the type of the action that takes a snapshot of a
+	// Droplet.
+	ActionTypeSnapshot = "snapshot"
+
+	// ActionStatusErrored is the status of an action that failed.
+	ActionStatusErrored = "errored"
+
+	// AnnotationKeySnapshotAction is the annotation that records the ID of
+	// the action taking the snapshot of a DropletSnapshot. Annotations set
+	// during Create are persisted, unlike its status.
+	AnnotationKeySnapshotAction = "compute.do.crossplane.io/snapshot-action-id"
+)
+
+// GenerateVolumeSnapshot generates *godo.SnapshotCreateRequest instance from
+// VolumeSnapshotParameters.
+func GenerateVolumeSnapshot(name string, in v1alpha1.VolumeSnapshotParameters) *godo.SnapshotCreateRequest {
+	return &godo.SnapshotCreateRequest{
+		VolumeID: do.StringValue(in.VolumeID),
+		Name:     name,
+		Tags:     in.Tags,
+	}
+}
+
+// GenerateSnapshotObservation generates a SnapshotObservation from the
+// supplied godo.Snapshot.
+func GenerateSnapshotObservation(observed godo.Snapshot) v1alpha1.SnapshotObservation {
+	return v1alpha1.SnapshotObservation{
+		ID:            observed.ID,
+		Name:          observed.Name,
+		SizeGigabytes: strconv.FormatFloat(observed.SizeGigaBytes, 'f', -1, 64),
+		MinDiskSize:   observed.MinDiskSize,
+		Regions:       observed.Regions,
+		CreatedAt:     observed.Created,
+		Tags:          observed.Tags,
+	}
+}
+
+// FindSnapshotImage returns the snapshot image with the supplied name, or nil
+// if none of the supplied images has that name.
+func FindSnapshotImage(images []godo.Image, name string) *godo.Image {
+	for i := range images {
+		if images[i].Name == name {
+			return &images[i]
+		}
+	}
+	return nil
+}
+
+// SnapshotPending returns true if the supplied action takes a snapshot and has
+// not errored. A completed snapshot remains pending until its image is listed
+// for the Droplet.
+func SnapshotPending(action godo.Action) bool {
+	return action.Type == ActionTypeSnapshot && action.Status != ActionStatusErrored
+}
@@ -0,0 +1,44 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindSnapshotImage(t *testing.T) {
+	images := []godo.Image{{ID: 1, Name: "golden-v1"}, {ID: 2, Name: "golden-v2"}}
+
+	assert.Equal(t, &images[1], FindSnapshotImage(images, "golden-v2"))
+	assert.Nil(t, FindSnapshotImage(images, "golden-v3"))
+}
+
+func TestSnapshotPending(t *testing.T) {
+	tests := map[string]struct {
+		action godo.Action
+		want   bool
+	}{
+		"InProgress": {
+			action: godo.Action{Type: ActionTypeSnapshot, Status: godo.ActionInProgress},
+			want:   true,
+		},
+		"Completed": {
+			action: godo.Action{Type: ActionTypeSnapshot, Status: godo.ActionCompleted},
+			want:   true,
+		},
+		"Errored": {
+			action: godo.Action{Type: ActionTypeSnapshot, Status: ActionStatusErrored},
+			want:   false,
+		},
+		"OtherAction": {
+			action: godo.Action{Type: "resize", Status: godo.ActionInProgress},
+			want:   false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, SnapshotPending(tc.action))
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+)
+
+// snapshotTimeFormat is the format of the time at which a scheduled snapshot
+// was taken in its name.
+const snapshotTimeFormat = "20060102-150405"
+
+// ScheduleSnapshots records the next time at which the supplied schedule takes
+// snapshots in the supplied observation, following its most recent run or,
+// if it never ran, the supplied time at which it was created. It returns an
+// error if the schedule is not a valid cron expression.
+func ScheduleSnapshots(p v1alpha1.SnapshotScheduleParameters, o *v1alpha1.SnapshotScheduleObservation, created time.Time) error {
+	schedule, err := cron.ParseStandard(p.Schedule)
+	if err != nil {
+		return err
+	}
+	last := created
+	if o.LastRunAt != nil {
+		last = o.LastRunAt.Time
+	}
+	next := metav1.NewTime(schedule.Next(last))
+	o.NextRunAt = &next
+	return nil
+}
+
+// SnapshotsDue returns true if the supplied schedule should take snapshots at
+// the supplied time.
+func SnapshotsDue(o v1alpha1.SnapshotScheduleObservation, now time.Time) bool {
+	return o.NextRunAt != nil && !now.Before(o.NextRunAt.Time)
+}
+
+// ScheduledSnapshotName returns the name of the snapshot taken by the schedule
+// with the supplied name at the supplied time.
+func ScheduledSnapshotName(schedule string, now time.Time) string {
+	return ScheduledSnapshotPrefix(schedule) + now.UTC().Format(snapshotTimeFormat)
+}
+
+// ScheduledSnapshotPrefix returns the prefix of the names of the snapshots
+// taken by the schedule with the supplied name.
+func ScheduledSnapshotPrefix(schedule string) string {
+	return schedule + "-"
+}
+
+// IsScheduledSnapshot returns true if the snapshot with the supplied name was
+// taken by the schedule with the supplied name, i.e. if its name is the name
+// of the schedule followed by the time at which it was taken. Snapshots of
+// schedules whose names start with the supplied name are not included.
+func IsScheduledSnapshot(name, schedule string) bool {
+	prefix := ScheduledSnapshotPrefix(schedule)
+	if !strings.HasPrefix(name, prefix) {
+		return false
+	}
+	_, err := time.Parse(snapshotTimeFormat, strings.TrimPrefix(name, prefix))
+	return err == nil
+}
+
+// ExpiredSnapshots returns the snapshot images of a Droplet that were taken by
+// the schedule with the supplied name and are expired according to the
+// supplied retention policy. The snapshot with the supplied name was just
+// requested, and counts towards the snapshots to keep while it is not among
+// the images yet. Images whose creation time is unknown are never expired.
+func ExpiredSnapshots(p *v1alpha1.SnapshotRetentionPolicy, images []godo.Image, schedule, taken string, now time.Time) []godo.Image {
+	if p == nil || (p.KeepLast == nil && p.MaxAgeDays == nil) {
+		return nil
+	}
+
+	pending := taken != ""
+	candidates := make([]godo.Image, 0, len(images))
+	for _, i := range images {
+		if i.Name == taken {
+			pending = false
+		}
+		if _, ok := imageCreated(i); ok && IsScheduledSnapshot(i.Name, schedule) {
+			candidates = append(candidates, i)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ci, _ := imageCreated(candidates[i])
+		cj, _ := imageCreated(candidates[j])
+		return ci.After(cj)
+	})
+
+	if p.KeepLast != nil {
+		keep := *p.KeepLast
+		if pending {
+			keep--
+		}
+		if keep >= len(candidates) {
+			return nil
+		}
+		candidates = candidates[keep:]
+	}
+	if p.MaxAgeDays == nil {
+		return candidates
+	}
+
+	cutoff := now.AddDate(0, 0, -*p.MaxAgeDays)
+	var expired []godo.Image
+	for _, i := range candidates {
+		if created, _ := imageCreated(i); created.Before(cutoff) {
+			expired = append(expired, i)
+		}
+	}
+	return expired
+}
+
+// imageCreated returns the time at which the supplied image was created, and
+// whether that time could be parsed.
+func imageCreated(i godo.Image) (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339, i.Created)
+	return t, err == nil
+}
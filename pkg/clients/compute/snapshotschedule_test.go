@@ -0,0 +1,161 @@
+package compute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+)
+
+func TestScheduleSnapshots(t *testing.T) {
+	created := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+	lastRun := metav1.NewTime(time.Date(2021, 9, 3, 3, 0, 0, 0, time.UTC))
+	tests := map[string]struct {
+		schedule string
+		o        v1alpha1.SnapshotScheduleObservation
+		want     time.Time
+		wantErr  bool
+	}{
+		"NeverRun": {
+			schedule: "0 3 * * *",
+			want:     time.Date(2021, 9, 2, 3, 0, 0, 0, time.UTC),
+		},
+		"Ran": {
+			schedule: "0 3 * * *",
+			o:        v1alpha1.SnapshotScheduleObservation{LastRunAt: &lastRun},
+			want:     time.Date(2021, 9, 4, 3, 0, 0, 0, time.UTC),
+		},
+		"InvalidSchedule": {
+			schedule: "every day",
+			wantErr:  true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ScheduleSnapshots(v1alpha1.SnapshotScheduleParameters{Schedule: tc.schedule}, &tc.o, created)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if err == nil {
+				assert.True(t, tc.want.Equal(tc.o.NextRunAt.Time))
+				assert.False(t, SnapshotsDue(tc.o, tc.want.Add(-time.Second)))
+				assert.True(t, SnapshotsDue(tc.o, tc.want))
+			}
+		})
+	}
+}
+
+func TestScheduledSnapshotName(t *testing.T) {
+	now := time.Date(2021, 9, 9, 3, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	assert.Equal(t, "nightly-20210909-010000", ScheduledSnapshotName("nightly", now))
+}
+
+func TestIsScheduledSnapshot(t *testing.T) {
+	tests := map[string]struct {
+		name string
+		want bool
+	}{
+		"Scheduled": {
+			name: "web-20210909-030000",
+			want: true,
+		},
+		"OtherSchedule": {
+			name: "web-prod-20210909-030000",
+			want: false,
+		},
+		"NotScheduled": {
+			name: "web-golden",
+			want: false,
+		},
+		"TrailingText": {
+			name: "web-20210909-030000-copy",
+			want: false,
+		},
+		"NoPrefix": {
+			name: "20210909-030000",
+			want: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsScheduledSnapshot(tc.name, "web"))
+		})
+	}
+}
+
+func TestExpiredSnapshots(t *testing.T) {
+	now := time.Date(2021, 9, 10, 0, 0, 0, 0, time.UTC)
+	image := func(id int, name string, daysAgo int) godo.Image {
+		return godo.Image{ID: id, Name: name, Created: now.AddDate(0, 0, -daysAgo).Format(time.RFC3339)}
+	}
+	images := []godo.Image{
+		image(1, "nightly-20210909-030000", 1),
+		image(2, "golden-image", 30),
+		image(3, "nightly-20210901-030000", 9),
+		image(4, "nightly-20210905-030000", 5),
+		image(5, "nightly-prod-20210801-030000", 40),
+		{ID: 6, Name: "nightly-20210802-030000", Created: "yesterday"},
+	}
+	taken := ScheduledSnapshotName("nightly", now)
+	keepOne := 1
+	keepTwo := 2
+	maxAgeDays := 7
+	tests := map[string]struct {
+		p      *v1alpha1.SnapshotRetentionPolicy
+		images []godo.Image
+		taken  string
+		want   []int
+	}{
+		"NoPolicy": {
+			p:      nil,
+			images: images,
+			want:   nil,
+		},
+		"KeepLast": {
+			p:      &v1alpha1.SnapshotRetentionPolicy{KeepLast: &keepOne},
+			images: images,
+			want:   []int{4, 3},
+		},
+		"KeepLastCountsTakenSnapshot": {
+			p:      &v1alpha1.SnapshotRetentionPolicy{KeepLast: &keepTwo},
+			images: images,
+			taken:  taken,
+			want:   []int{4, 3},
+		},
+		"KeepLastOnlyTakenSnapshot": {
+			p:      &v1alpha1.SnapshotRetentionPolicy{KeepLast: &keepOne},
+			images: images,
+			taken:  taken,
+			want:   []int{1, 4, 3},
+		},
+		"KeepLastTakenSnapshotListed": {
+			p:      &v1alpha1.SnapshotRetentionPolicy{KeepLast: &keepTwo},
+			images: append([]godo.Image{image(7, taken, 0)}, images...),
+			taken:  taken,
+			want:   []int{4, 3},
+		},
+		"MaxAge": {
+			p:      &v1alpha1.SnapshotRetentionPolicy{MaxAgeDays: &maxAgeDays},
+			images: images,
+			want:   []int{3},
+		},
+		"KeepLastAndMaxAge": {
+			p:      &v1alpha1.SnapshotRetentionPolicy{KeepLast: &keepOne, MaxAgeDays: &maxAgeDays},
+			images: images,
+			taken:  taken,
+			want:   []int{3},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got []int
+			for _, i := range ExpiredSnapshots(tc.p, tc.images, "nightly", tc.taken, now) {
+				got = append(got, i.ID)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
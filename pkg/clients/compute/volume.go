@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+)
+
+const (
+	// AnnotationKeyResizeAction is the annotation that records the ID of the
+	// action resizing a Volume, so that it is not resized again while the
+	// action is in progress.
+	AnnotationKeyResizeAction = "compute.do.crossplane.io/resize-action-id"
+
+	errVolumeShrink = "volumes cannot shrink from %d GiB to %d GiB"
+)
+
+// GenerateVolume generates *godo.VolumeCreateRequest instance from VolumeParameters.
+func GenerateVolume(name string, in v1alpha1.VolumeParameters, create *godo.VolumeCreateRequest) {
+	create.Name = name
+	create.Region = in.Region
+	create.SizeGigaBytes = in.SizeGigabytes
+	create.Description = do.StringValue(in.Description)
+	create.SnapshotID = do.StringValue(in.SnapshotID)
+	create.FilesystemType = do.StringValue(in.FilesystemType)
+	create.FilesystemLabel = do.StringValue(in.FilesystemLabel)
+	create.Tags = in.Tags
+}
+
+// GenerateVolumeObservation generates a VolumeObservation from the supplied
+// godo.Volume.
+func GenerateVolumeObservation(observed godo.Volume) v1alpha1.VolumeObservation {
+	o := v1alpha1.VolumeObservation{
+		ID:              observed.ID,
+		Name:            observed.Name,
+		SizeGigabytes:   observed.SizeGigaBytes,
+		Description:     observed.Description,
+		DropletIDs:      observed.DropletIDs,
+		CreatedAt:       observed.CreatedAt.Format(time.RFC3339),
+		FilesystemType:  observed.FilesystemType,
+		FilesystemLabel: observed.FilesystemLabel,
+		Tags:            observed.Tags,
+	}
+	if observed.Region != nil {
+		o.Region = observed.Region.Slug
+	}
+	return o
+}
+
+// VolumeLateInitializeSpec updates any unset (i.e. nil) optional fields of
+// the supplied VolumeParameters that are set (i.e. non-zero) on the supplied
+// Volume.
+func VolumeLateInitializeSpec(p *v1alpha1.VolumeParameters, observed godo.Volume) {
+	p.Description = do.LateInitializeString(p.Description, observed.Description)
+	p.FilesystemType = do.LateInitializeString(p.FilesystemType, observed.FilesystemType)
+	p.FilesystemLabel = do.LateInitializeString(p.FilesystemLabel, observed.FilesystemLabel)
+	p.Tags = do.LateInitializeStringSlice(p.Tags, observed.Tags)
+}
+
+// ValidateVolumeSize returns an error if the supplied VolumeParameters would
+// shrink the supplied observed Volume.
+func ValidateVolumeSize(p v1alpha1.VolumeParameters, observed v1alpha1.VolumeObservation) error {
+	if p.SizeGigabytes < observed.SizeGigabytes {
+		return errors.Errorf(errVolumeShrink, observed.SizeGigabytes, p.SizeGigabytes)
+	}
+	return nil
+}
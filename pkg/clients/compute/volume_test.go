@@ -0,0 +1,57 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+)
+
+func TestGenerateVolume(t *testing.T) {
+	description := "mock-description"
+	snapshotID := "mock-snapshot"
+	filesystemType := "ext4"
+	filesystemLabel := "mock-label"
+
+	create := &godo.VolumeCreateRequest{}
+	GenerateVolume("mock-volume", v1alpha1.VolumeParameters{
+		Region:          region,
+		SizeGigabytes:   100,
+		Description:     &description,
+		SnapshotID:      &snapshotID,
+		FilesystemType:  &filesystemType,
+		FilesystemLabel: &filesystemLabel,
+		Tags:            tags,
+	}, create)
+
+	assert.Equal(t, &godo.VolumeCreateRequest{
+		Name:            "mock-volume",
+		Region:          region,
+		SizeGigaBytes:   100,
+		Description:     description,
+		SnapshotID:      snapshotID,
+		FilesystemType:  filesystemType,
+		FilesystemLabel: filesystemLabel,
+		Tags:            tags,
+	}, create)
+}
+
+func TestValidateVolumeSize(t *testing.T) {
+	observed := v1alpha1.VolumeObservation{SizeGigabytes: 100}
+	tests := map[string]struct {
+		size    int64
+		wantErr bool
+	}{
+		"Unchanged": {size: 100},
+		"Grow":      {size: 200},
+		"Shrink":    {size: 50, wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateVolumeSize(v1alpha1.VolumeParameters{SizeGigabytes: tc.size}, observed)
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+)
+
+const (
+	// AnnotationKeyAttachmentAction is the annotation that records the ID of
+	// the action attaching or detaching a Volume, so that it is not issued
+	// again while the action is in progress.
+	AnnotationKeyAttachmentAction = "compute.do.crossplane.io/attachment-action-id"
+
+	errInvalidDropletID = "invalid Droplet ID %q"
+)
+
+// ParseDropletID parses the supplied Droplet ID.
+func ParseDropletID(id *string) (int, error) {
+	if id == nil {
+		return 0, errors.Errorf(errInvalidDropletID, "")
+	}
+	dropletID, err := strconv.Atoi(*id)
+	return dropletID, errors.Wrapf(err, errInvalidDropletID, *id)
+}
+
+// IsAttached returns true if the supplied Volume is attached to the Droplet
+// with the supplied ID.
+func IsAttached(volume godo.Volume, dropletID int) bool {
+	for _, id := range volume.DropletIDs {
+		if id == dropletID {
+			return true
+		}
+	}
+	return false
+}
+
+// StorageActionInProgress returns true if the action whose ID is recorded in
+// the supplied annotation of the supplied object is in progress on the Volume
+// with the supplied ID. Actions that are not recorded or no longer exist are
+// not in progress.
+func StorageActionInProgress(ctx context.Context, s godo.StorageActionsService, o metav1.Object, key, volumeID string) (bool, error) {
+	id, err := strconv.Atoi(o.GetAnnotations()[key])
+	if err != nil {
+		return false, nil
+	}
+	action, response, err := s.Get(ctx, volumeID, id)
+	if err != nil {
+		return false, do.IgnoreNotFound(err, response)
+	}
+	return action.Status == godo.ActionInProgress, nil
+}
@@ -0,0 +1,114 @@
+package compute
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute/fake"
+)
+
+func TestParseDropletID(t *testing.T) {
+	valid := "123"
+	invalid := "droplet"
+
+	type want struct {
+		id  int
+		err bool
+	}
+	tests := map[string]struct {
+		id   *string
+		want want
+	}{
+		"Valid": {
+			id:   &valid,
+			want: want{id: 123},
+		},
+		"Unset": {
+			id:   nil,
+			want: want{err: true},
+		},
+		"Invalid": {
+			id:   &invalid,
+			want: want{err: true},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			id, err := ParseDropletID(tc.id)
+			assert.Equal(t, tc.want.err, err != nil)
+			assert.Equal(t, tc.want.id, id)
+		})
+	}
+}
+
+func TestIsAttached(t *testing.T) {
+	volume := godo.Volume{DropletIDs: []int{1, 2}}
+	assert.True(t, IsAttached(volume, 2))
+	assert.False(t, IsAttached(volume, 3))
+	assert.False(t, IsAttached(godo.Volume{}, 1))
+}
+
+func TestStorageActionInProgress(t *testing.T) {
+	const key = "action-id"
+
+	type want struct {
+		inProgress bool
+		err        bool
+	}
+	tests := map[string]struct {
+		annotation string
+		action     *godo.Action
+		response   *godo.Response
+		getErr     error
+		want       want
+	}{
+		"NotRecorded": {},
+		"InProgress": {
+			annotation: "1",
+			action:     &godo.Action{ID: 1, Status: godo.ActionInProgress},
+			want:       want{inProgress: true},
+		},
+		"Completed": {
+			annotation: "1",
+			action:     &godo.Action{ID: 1, Status: godo.ActionCompleted},
+		},
+		"Errored": {
+			annotation: "1",
+			action:     &godo.Action{ID: 1, Status: "errored"},
+		},
+		"Gone": {
+			annotation: "1",
+			response:   &godo.Response{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			getErr:     errors.New("not found"),
+		},
+		"GetFailed": {
+			annotation: "1",
+			getErr:     errors.New("boom"),
+			want:       want{err: true},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			o := &metav1.ObjectMeta{}
+			if tc.annotation != "" {
+				o.SetAnnotations(map[string]string{key: tc.annotation})
+			}
+			s := &fake.MockStorageActionsService{
+				MockGet: func(_ context.Context, volumeID string, id int) (*godo.Action, *godo.Response, error) {
+					assert.Equal(t, "volume", volumeID)
+					assert.Equal(t, 1, id)
+					return tc.action, tc.response, tc.getErr
+				},
+			}
+			inProgress, err := StorageActionInProgress(context.Background(), s, o, key, "volume")
+			assert.Equal(t, tc.want.err, err != nil)
+			assert.Equal(t, tc.want.inProgress, inProgress)
+		})
+	}
+}
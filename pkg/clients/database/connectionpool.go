@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+)
+
+const (
+	databasePoolPath = "/v2/databases/%s/pools/%s"
+
+	errPoolEngineNotSupported = "connection pools are only supported by PostgreSQL Database Clusters, not by engine %q"
+)
+
+// DatabaseUpdatePoolRequest is used to update a connection pool. It is not
+// provided by godo yet.
+type DatabaseUpdatePoolRequest struct {
+	User     string `json:"user,omitempty"`
+	Size     int    `json:"size"`
+	Database string `json:"db"`
+	Mode     string `json:"mode"`
+}
+
+// ValidatePoolEngine returns an error if Database Clusters with the supplied
+// engine do not support connection pools.
+func ValidatePoolEngine(engine string) error {
+	if engine != v1alpha1.EnginePostgres {
+		return errors.Errorf(errPoolEngineNotSupported, engine)
+	}
+	return nil
+}
+
+// GenerateCreatePoolRequest generates *godo.DatabaseCreatePoolRequest instance from DODatabaseConnectionPoolParameters.
+func GenerateCreatePoolRequest(name string, in v1alpha1.DODatabaseConnectionPoolParameters) *godo.DatabaseCreatePoolRequest {
+	return &godo.DatabaseCreatePoolRequest{
+		Name:     name,
+		Mode:     in.Mode,
+		Size:     in.Size,
+		Database: do.StringValue(in.Database),
+		User:     do.StringValue(in.User),
+	}
+}
+
+// GenerateUpdatePoolRequest generates *DatabaseUpdatePoolRequest instance from DODatabaseConnectionPoolParameters.
+func GenerateUpdatePoolRequest(in v1alpha1.DODatabaseConnectionPoolParameters) *DatabaseUpdatePoolRequest {
+	return &DatabaseUpdatePoolRequest{
+		Mode:     in.Mode,
+		Size:     in.Size,
+		Database: do.StringValue(in.Database),
+		User:     do.StringValue(in.User),
+	}
+}
+
+// GeneratePoolObservation generates DODatabaseConnectionPoolObservation instance from godo.DatabasePool.
+func GeneratePoolObservation(pool *godo.DatabasePool) v1alpha1.DODatabaseConnectionPoolObservation {
+	return v1alpha1.DODatabaseConnectionPoolObservation{
+		Name:     pool.Name,
+		Mode:     pool.Mode,
+		Size:     pool.Size,
+		Database: pool.Database,
+		User:     pool.User,
+	}
+}
+
+// PoolLateInitializeSpec updates any unset (i.e. nil) optional fields of the
+// supplied DODatabaseConnectionPoolParameters that are set (i.e. non-zero) on
+// the supplied connection pool.
+func PoolLateInitializeSpec(p *v1alpha1.DODatabaseConnectionPoolParameters, observed godo.DatabasePool) {
+	p.Database = do.LateInitializeString(p.Database, observed.Database)
+	p.User = do.LateInitializeString(p.User, observed.User)
+}
+
+// PoolIsUpToDate returns true if the supplied connection pool matches the
+// supplied DODatabaseConnectionPoolParameters.
+func PoolIsUpToDate(p v1alpha1.DODatabaseConnectionPoolParameters, observed godo.DatabasePool) bool {
+	return p.Mode == observed.Mode &&
+		p.Size == observed.Size &&
+		do.StringValue(p.Database) == observed.Database &&
+		(p.User == nil || *p.User == observed.User)
+}
+
+// UpdatePool updates the connection pool with the supplied name of the
+// Database Cluster with the supplied ID.
+func UpdatePool(ctx context.Context, client *godo.Client, clusterID, name string, update *DatabaseUpdatePoolRequest) (*godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodPut, fmt.Sprintf(databasePoolPath, clusterID, name), update)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(ctx, req, nil)
+}
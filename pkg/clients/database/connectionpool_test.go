@@ -0,0 +1,59 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+)
+
+func TestValidatePoolEngine(t *testing.T) {
+	tests := map[string]struct {
+		engine string
+		want   bool
+	}{
+		"Postgres": {engine: v1alpha1.EnginePostgres, want: false},
+		"MySQL":    {engine: v1alpha1.EngineMySQL, want: true},
+		"Redis":    {engine: v1alpha1.EngineRedis, want: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ValidatePoolEngine(tc.engine) != nil)
+		})
+	}
+}
+
+func TestPoolIsUpToDate(t *testing.T) {
+	db := "app"
+	user := "app"
+	observed := godo.DatabasePool{Name: "pool", Mode: v1alpha1.PoolModeTransaction, Size: 10, Database: "app", User: "app"}
+
+	tests := map[string]struct {
+		params v1alpha1.DODatabaseConnectionPoolParameters
+		want   bool
+	}{
+		"UpToDate": {
+			params: v1alpha1.DODatabaseConnectionPoolParameters{Mode: v1alpha1.PoolModeTransaction, Size: 10, Database: &db, User: &user},
+			want:   true,
+		},
+		"InboundUser": {
+			params: v1alpha1.DODatabaseConnectionPoolParameters{Mode: v1alpha1.PoolModeTransaction, Size: 10, Database: &db},
+			want:   true,
+		},
+		"SizeChanged": {
+			params: v1alpha1.DODatabaseConnectionPoolParameters{Mode: v1alpha1.PoolModeTransaction, Size: 20, Database: &db, User: &user},
+			want:   false,
+		},
+		"ModeChanged": {
+			params: v1alpha1.DODatabaseConnectionPoolParameters{Mode: v1alpha1.PoolModeSession, Size: 10, Database: &db, User: &user},
+			want:   false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, PoolIsUpToDate(tc.params, observed))
+		})
+	}
+}
@@ -14,12 +14,62 @@ limitations under the License.
 package database
 
 import (
+	"strconv"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/digitalocean/godo"
 
 	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
 	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
 )
 
+// Connection secret keys of the resources of this API group in addition to the
+// common Crossplane ones.
+const (
+	ConnectionSecretURIKey         = "uri"
+	ConnectionSecretPrivateURIKey  = "privateUri"
+	ConnectionSecretHostKey        = "host"
+	ConnectionSecretPrivateHostKey = "privateHost"
+	ConnectionSecretDatabaseKey    = "database"
+	ConnectionSecretCAKey          = "ca.crt"
+)
+
+// GenerateClusterConnectionDetails generates the connection details of the
+// supplied Database Cluster. The endpoint points to the private connection of
+// the cluster when the private endpoint network is requested.
+func GenerateClusterConnectionDetails(p v1alpha1.DODatabaseClusterParameters, db *godo.Database, ca *godo.DatabaseCA) managed.ConnectionDetails {
+	cd := GenerateConnectionDetails(db.Connection, db.PrivateConnection)
+	if db.PrivateConnection != nil && p.EndpointNetwork != nil && *p.EndpointNetwork == v1alpha1.EndpointNetworkPrivate {
+		cd[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(db.PrivateConnection.URI)
+	}
+	if ca != nil && len(ca.Certificate) > 0 {
+		cd[ConnectionSecretCAKey] = ca.Certificate
+	}
+	return cd
+}
+
+// GenerateConnectionDetails generates the connection details of the supplied
+// public and private connection, each of which may be nil.
+func GenerateConnectionDetails(public, private *godo.DatabaseConnection) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if c := public; c != nil {
+		cd[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(c.URI)
+		cd[ConnectionSecretURIKey] = []byte(c.URI)
+		cd[ConnectionSecretHostKey] = []byte(c.Host)
+		cd[xpv1.ResourceCredentialsSecretPortKey] = []byte(strconv.Itoa(c.Port))
+		cd[xpv1.ResourceCredentialsSecretUserKey] = []byte(c.User)
+		cd[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(c.Password)
+		cd[ConnectionSecretDatabaseKey] = []byte(c.Database)
+	}
+	if c := private; c != nil {
+		cd[ConnectionSecretPrivateURIKey] = []byte(c.URI)
+		cd[ConnectionSecretPrivateHostKey] = []byte(c.Host)
+	}
+	return cd
+}
+
 // GenerateDatabase generates *godo.DatabaseRequest instance from LBParameters.
 func GenerateDatabase(name string, in v1alpha1.DODatabaseClusterParameters, create *godo.DatabaseCreateRequest) {
 	create.Name = name
@@ -30,6 +80,34 @@ func GenerateDatabase(name string, in v1alpha1.DODatabaseClusterParameters, crea
 	create.Region = in.Region
 	create.PrivateNetworkUUID = do.StringValue(in.PrivateNetworkUUID)
 	create.Tags = in.Tags
+	if r := in.RestoreFrom; r != nil {
+		create.BackupRestore = &godo.DatabaseBackupRestore{
+			DatabaseName:    do.StringValue(r.DatabaseName),
+			BackupCreatedAt: do.StringValue(r.BackupCreatedAt),
+		}
+	}
+}
+
+// GenerateBackupObservations generates DODatabaseClusterBackup instances from
+// the supplied godo.DatabaseBackups.
+func GenerateBackupObservations(backups []godo.DatabaseBackup) []v1alpha1.DODatabaseClusterBackup {
+	if len(backups) == 0 {
+		return nil
+	}
+	o := make([]v1alpha1.DODatabaseClusterBackup, len(backups))
+	for i, b := range backups {
+		o[i] = v1alpha1.DODatabaseClusterBackup{
+			CreatedAt:     b.CreatedAt.UTC().Format(time.RFC3339),
+			SizeGigabytes: strconv.FormatFloat(b.SizeGigabytes, 'f', -1, 64),
+		}
+	}
+	return o
+}
+
+// SupportsBackups returns true if clusters of the supplied engine keep backups
+// from which they can be restored.
+func SupportsBackups(engine string) bool {
+	return engine != v1alpha1.EngineRedis
 }
 
 // LateInitializeSpec updates any unset (i.e. nil) optional fields of the
@@ -44,3 +122,74 @@ func LateInitializeSpec(p *v1alpha1.DODatabaseClusterParameters, observed godo.D
 		copy(p.Tags, observed.Tags)
 	}
 }
+
+// GenerateResizeRequest generates *godo.DatabaseResizeRequest instance from
+// DODatabaseClusterParameters if the size or number of nodes of the supplied
+// Database Cluster differ from the desired ones. Otherwise nil is returned.
+func GenerateResizeRequest(p v1alpha1.DODatabaseClusterParameters, observed v1alpha1.DODatabaseClusterObservation) *godo.DatabaseResizeRequest {
+	if p.Size == observed.Size && p.NumNodes == observed.NumNodes {
+		return nil
+	}
+	return &godo.DatabaseResizeRequest{
+		SizeSlug: p.Size,
+		NumNodes: p.NumNodes,
+	}
+}
+
+// GenerateMigrateRequest generates *godo.DatabaseMigrateRequest instance from
+// DODatabaseClusterParameters if the region of the observed Database Cluster
+// differs from the desired one. Otherwise nil is returned. The VPC is only
+// requested if it differs from the current one, which is late initialized
+// and belongs to the previous region; otherwise DigitalOcean places the
+// cluster in the default VPC of the new region.
+func GenerateMigrateRequest(p v1alpha1.DODatabaseClusterParameters, observed v1alpha1.DODatabaseClusterObservation) *godo.DatabaseMigrateRequest {
+	if p.Region == observed.Region {
+		return nil
+	}
+	migrate := &godo.DatabaseMigrateRequest{Region: p.Region}
+	if vpc := do.StringValue(p.PrivateNetworkUUID); vpc != observed.PrivateNetworkUUID {
+		migrate.PrivateNetworkUUID = vpc
+	}
+	return migrate
+}
+
+// GenerateMaintenanceRequest generates *godo.DatabaseUpdateMaintenanceRequest
+// instance from DODatabaseClusterParameters if the maintenance window of the
+// observed Database Cluster differs from the desired one. Otherwise nil is
+// returned.
+func GenerateMaintenanceRequest(p v1alpha1.DODatabaseClusterParameters, observed v1alpha1.DODatabaseClusterObservation) *godo.DatabaseUpdateMaintenanceRequest {
+	w := p.MaintenanceWindow
+	if w == nil {
+		return nil
+	}
+	if w.Day == observed.MaintenanceWindow.Day && sameHour(w.Hour, observed.MaintenanceWindow.Hour) {
+		return nil
+	}
+	return &godo.DatabaseUpdateMaintenanceRequest{
+		Day:  w.Day,
+		Hour: w.Hour,
+	}
+}
+
+// IsUpToDate returns true if the observed Database Cluster does not need to
+// be resized, migrated or have its maintenance window updated.
+func IsUpToDate(p v1alpha1.DODatabaseClusterParameters, observed v1alpha1.DODatabaseClusterObservation) bool {
+	return GenerateResizeRequest(p, observed) == nil &&
+		GenerateMigrateRequest(p, observed) == nil &&
+		GenerateMaintenanceRequest(p, observed) == nil
+}
+
+// sameHour returns true if the supplied hours in 24 hour format refer to the
+// same time of day. DigitalOcean may report hours including seconds.
+func sameHour(a, b string) bool {
+	return parseHour(a) == parseHour(b)
+}
+
+func parseHour(hour string) string {
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		if t, err := time.Parse(layout, hour); err == nil {
+			return t.Format("15:04")
+		}
+	}
+	return hour
+}
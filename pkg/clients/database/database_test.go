@@ -0,0 +1,182 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+)
+
+var observedCluster = v1alpha1.DODatabaseClusterObservation{
+	NumNodes:           1,
+	Size:               "db-s-1vcpu-1gb",
+	Region:             "nyc3",
+	PrivateNetworkUUID: "nyc3-vpc",
+	MaintenanceWindow: v1alpha1.DODatabaseClusterMaintenanceWindow{
+		Day:  "tuesday",
+		Hour: "14:00:00",
+	},
+}
+
+func TestGenerateResizeRequest(t *testing.T) {
+	tests := map[string]struct {
+		params v1alpha1.DODatabaseClusterParameters
+		want   *godo.DatabaseResizeRequest
+	}{
+		"UpToDate": {
+			params: v1alpha1.DODatabaseClusterParameters{NumNodes: 1, Size: "db-s-1vcpu-1gb"},
+			want:   nil,
+		},
+		"NumNodesChanged": {
+			params: v1alpha1.DODatabaseClusterParameters{NumNodes: 3, Size: "db-s-1vcpu-1gb"},
+			want:   &godo.DatabaseResizeRequest{NumNodes: 3, SizeSlug: "db-s-1vcpu-1gb"},
+		},
+		"SizeChanged": {
+			params: v1alpha1.DODatabaseClusterParameters{NumNodes: 1, Size: "db-s-2vcpu-4gb"},
+			want:   &godo.DatabaseResizeRequest{NumNodes: 1, SizeSlug: "db-s-2vcpu-4gb"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GenerateResizeRequest(tc.params, observedCluster))
+		})
+	}
+}
+
+func TestGenerateMigrateRequest(t *testing.T) {
+	vpc := "ams3-vpc"
+	current := observedCluster.PrivateNetworkUUID
+	tests := map[string]struct {
+		params v1alpha1.DODatabaseClusterParameters
+		want   *godo.DatabaseMigrateRequest
+	}{
+		"UpToDate": {
+			params: v1alpha1.DODatabaseClusterParameters{Region: "nyc3"},
+			want:   nil,
+		},
+		"RegionChanged": {
+			params: v1alpha1.DODatabaseClusterParameters{Region: "ams3"},
+			want:   &godo.DatabaseMigrateRequest{Region: "ams3"},
+		},
+		"RegionChangedWithCurrentVPC": {
+			params: v1alpha1.DODatabaseClusterParameters{Region: "ams3", PrivateNetworkUUID: &current},
+			want:   &godo.DatabaseMigrateRequest{Region: "ams3"},
+		},
+		"RegionChangedWithNewVPC": {
+			params: v1alpha1.DODatabaseClusterParameters{Region: "ams3", PrivateNetworkUUID: &vpc},
+			want:   &godo.DatabaseMigrateRequest{Region: "ams3", PrivateNetworkUUID: vpc},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GenerateMigrateRequest(tc.params, observedCluster))
+		})
+	}
+}
+
+func TestGenerateMaintenanceRequest(t *testing.T) {
+	tests := map[string]struct {
+		params v1alpha1.DODatabaseClusterParameters
+		want   *godo.DatabaseUpdateMaintenanceRequest
+	}{
+		"NotManaged": {
+			params: v1alpha1.DODatabaseClusterParameters{},
+			want:   nil,
+		},
+		"UpToDate": {
+			params: v1alpha1.DODatabaseClusterParameters{
+				MaintenanceWindow: &v1alpha1.DODatabaseClusterMaintenanceWindowParameters{Day: "tuesday", Hour: "14:00"},
+			},
+			want: nil,
+		},
+		"HourChanged": {
+			params: v1alpha1.DODatabaseClusterParameters{
+				MaintenanceWindow: &v1alpha1.DODatabaseClusterMaintenanceWindowParameters{Day: "tuesday", Hour: "03:00"},
+			},
+			want: &godo.DatabaseUpdateMaintenanceRequest{Day: "tuesday", Hour: "03:00"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GenerateMaintenanceRequest(tc.params, observedCluster))
+		})
+	}
+}
+
+func TestGenerateClusterConnectionDetails(t *testing.T) {
+	private := v1alpha1.EndpointNetworkPrivate
+	db := &godo.Database{
+		Connection:        &godo.DatabaseConnection{URI: "public-uri", Host: "public-host", Port: 25060, User: "doadmin", Password: "secret", Database: "defaultdb"},
+		PrivateConnection: &godo.DatabaseConnection{URI: "private-uri", Host: "private-host"},
+	}
+	tests := map[string]struct {
+		params       v1alpha1.DODatabaseClusterParameters
+		ca           *godo.DatabaseCA
+		wantEndpoint string
+		wantCA       []byte
+	}{
+		"PublicEndpoint": {
+			params:       v1alpha1.DODatabaseClusterParameters{},
+			wantEndpoint: "public-uri",
+		},
+		"PrivateEndpointWithCA": {
+			params:       v1alpha1.DODatabaseClusterParameters{EndpointNetwork: &private},
+			ca:           &godo.DatabaseCA{Certificate: []byte("cert")},
+			wantEndpoint: "private-uri",
+			wantCA:       []byte("cert"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cd := GenerateClusterConnectionDetails(tc.params, db, tc.ca)
+			assert.Equal(t, []byte(tc.wantEndpoint), cd[xpv1.ResourceCredentialsSecretEndpointKey])
+			assert.Equal(t, []byte("public-uri"), cd[ConnectionSecretURIKey])
+			assert.Equal(t, []byte("private-uri"), cd[ConnectionSecretPrivateURIKey])
+			assert.Equal(t, []byte("25060"), cd[xpv1.ResourceCredentialsSecretPortKey])
+			assert.Equal(t, tc.wantCA, cd[ConnectionSecretCAKey])
+		})
+	}
+}
+
+func TestGenerateDatabaseRestoreFrom(t *testing.T) {
+	source := "production"
+	createdAt := "2021-09-01T03:00:00Z"
+	tests := map[string]struct {
+		restore *v1alpha1.DODatabaseClusterRestoreFrom
+		want    *godo.DatabaseBackupRestore
+	}{
+		"NoRestore": {
+			want: nil,
+		},
+		"LatestBackup": {
+			restore: &v1alpha1.DODatabaseClusterRestoreFrom{DatabaseName: &source},
+			want:    &godo.DatabaseBackupRestore{DatabaseName: source},
+		},
+		"BackupAt": {
+			restore: &v1alpha1.DODatabaseClusterRestoreFrom{DatabaseName: &source, BackupCreatedAt: &createdAt},
+			want:    &godo.DatabaseBackupRestore{DatabaseName: source, BackupCreatedAt: createdAt},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			create := &godo.DatabaseCreateRequest{}
+			GenerateDatabase("staging", v1alpha1.DODatabaseClusterParameters{RestoreFrom: tc.restore}, create)
+			assert.Equal(t, tc.want, create.BackupRestore)
+		})
+	}
+}
+
+func TestGenerateBackupObservations(t *testing.T) {
+	backups := []godo.DatabaseBackup{
+		{CreatedAt: time.Date(2021, 9, 1, 3, 0, 0, 0, time.UTC), SizeGigabytes: 0.25},
+	}
+	want := []v1alpha1.DODatabaseClusterBackup{
+		{CreatedAt: "2021-09-01T03:00:00Z", SizeGigabytes: "0.25"},
+	}
+	assert.Equal(t, want, GenerateBackupObservations(backups))
+	assert.Nil(t, GenerateBackupObservations(nil))
+}
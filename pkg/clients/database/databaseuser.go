@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"net/url"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+)
+
+const (
+	errUserSettingsEngineMismatch = "mysql settings of Database Users are not supported by Database Clusters of engine %q"
+)
+
+// ValidateUserEngine returns an error if the supplied parameters contain
+// settings that are not supported by Database Clusters of the supplied engine.
+func ValidateUserEngine(p v1alpha1.DODatabaseUserParameters, engine string) error {
+	if p.MySQLSettings != nil && engine != v1alpha1.EngineMySQL {
+		return errors.Errorf(errUserSettingsEngineMismatch, engine)
+	}
+	return nil
+}
+
+// GenerateCreateUserRequest generates *godo.DatabaseCreateUserRequest instance from DODatabaseUserParameters.
+func GenerateCreateUserRequest(name string, in v1alpha1.DODatabaseUserParameters) *godo.DatabaseCreateUserRequest {
+	return &godo.DatabaseCreateUserRequest{
+		Name:          name,
+		MySQLSettings: generateMySQLUserSettings(in.MySQLSettings),
+	}
+}
+
+// GenerateResetUserAuthRequest generates *godo.DatabaseResetUserAuthRequest instance from DODatabaseUserParameters.
+func GenerateResetUserAuthRequest(in v1alpha1.DODatabaseUserParameters) *godo.DatabaseResetUserAuthRequest {
+	return &godo.DatabaseResetUserAuthRequest{
+		MySQLSettings: generateMySQLUserSettings(in.MySQLSettings),
+	}
+}
+
+func generateMySQLUserSettings(in *v1alpha1.DODatabaseUserMySQLSettings) *godo.DatabaseMySQLUserSettings {
+	if in == nil {
+		return nil
+	}
+	return &godo.DatabaseMySQLUserSettings{AuthPlugin: in.AuthPlugin}
+}
+
+// GenerateUserObservation generates DODatabaseUserObservation instance from
+// godo.DatabaseUser. The password generation and reset time are kept from the
+// supplied previous observation.
+func GenerateUserObservation(user *godo.DatabaseUser, previous v1alpha1.DODatabaseUserObservation) v1alpha1.DODatabaseUserObservation {
+	o := v1alpha1.DODatabaseUserObservation{
+		Name:               user.Name,
+		Role:               user.Role,
+		PasswordGeneration: previous.PasswordGeneration,
+		PasswordResetAt:    previous.PasswordResetAt,
+	}
+	if user.MySQLSettings != nil {
+		o.MySQLSettings = &v1alpha1.DODatabaseUserMySQLSettings{AuthPlugin: user.MySQLSettings.AuthPlugin}
+	}
+	return o
+}
+
+// UserAuthResetDue returns true if the authentication of the observed user
+// should be reset, either because a new password generation was requested or
+// because its MySQL settings differ from the desired ones. MySQL settings are
+// only compared when they are reported for the observed user, so that users of
+// other engines are not reset over and over.
+func UserAuthResetDue(p v1alpha1.DODatabaseUserParameters, o v1alpha1.DODatabaseUserObservation) bool {
+	if p.PasswordGeneration > o.PasswordGeneration {
+		return true
+	}
+	if p.MySQLSettings == nil || o.MySQLSettings == nil {
+		return false
+	}
+	return p.MySQLSettings.AuthPlugin != o.MySQLSettings.AuthPlugin
+}
+
+// GenerateUserConnectionDetails generates the connection details of the
+// supplied user of the supplied Database Cluster.
+func GenerateUserConnectionDetails(user *godo.DatabaseUser, cluster *godo.Database) managed.ConnectionDetails {
+	return GenerateConnectionDetails(userConnection(cluster.Connection, user), userConnection(cluster.PrivateConnection, user))
+}
+
+// userConnection returns the supplied connection of a Database Cluster with
+// its credentials replaced by the ones of the supplied user.
+func userConnection(c *godo.DatabaseConnection, user *godo.DatabaseUser) *godo.DatabaseConnection {
+	if c == nil {
+		return nil
+	}
+	uc := *c
+	uc.URI = UserURI(c.URI, user.Name, user.Password)
+	uc.User = user.Name
+	uc.Password = user.Password
+	return &uc
+}
+
+// UserURI returns the supplied connection URI of a Database Cluster with its
+// credentials replaced by the supplied ones. An empty string is returned if
+// the URI cannot be parsed.
+func UserURI(uri, user, password string) string {
+	u, err := url.Parse(uri)
+	if err != nil || uri == "" {
+		return ""
+	}
+	u.User = url.UserPassword(user, password)
+	return u.String()
+}
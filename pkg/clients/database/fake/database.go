@@ -0,0 +1,121 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+)
+
+// this ensures that the mock implements the client interface
+var _ godo.DatabasesService = (*MockDatabasesService)(nil)
+
+// MockDatabasesService is a type that implements the methods of
+// godo.DatabasesService used by the controllers of the database API group.
+// Calling any other method panics.
+type MockDatabasesService struct {
+	godo.DatabasesService
+
+	MockGet           func(context.Context, string) (*godo.Database, *godo.Response, error)
+	MockResize        func(context.Context, string, *godo.DatabaseResizeRequest) (*godo.Response, error)
+	MockMigrate       func(context.Context, string, *godo.DatabaseMigrateRequest) (*godo.Response, error)
+	MockGetUser       func(context.Context, string, string) (*godo.DatabaseUser, *godo.Response, error)
+	MockCreateUser    func(context.Context, string, *godo.DatabaseCreateUserRequest) (*godo.DatabaseUser, *godo.Response, error)
+	MockDeleteUser    func(context.Context, string, string) (*godo.Response, error)
+	MockResetUserAuth func(context.Context, string, string, *godo.DatabaseResetUserAuthRequest) (*godo.DatabaseUser, *godo.Response, error)
+	MockCreateDB      func(context.Context, string, *godo.DatabaseCreateDBRequest) (*godo.DatabaseDB, *godo.Response, error)
+	MockGetDB         func(context.Context, string, string) (*godo.DatabaseDB, *godo.Response, error)
+	MockDeleteDB      func(context.Context, string, string) (*godo.Response, error)
+	MockCreatePool    func(context.Context, string, *godo.DatabaseCreatePoolRequest) (*godo.DatabasePool, *godo.Response, error)
+	MockGetPool       func(context.Context, string, string) (*godo.DatabasePool, *godo.Response, error)
+	MockDeletePool    func(context.Context, string, string) (*godo.Response, error)
+	MockGetReplica    func(context.Context, string, string) (*godo.DatabaseReplica, *godo.Response, error)
+	MockCreateReplica func(context.Context, string, *godo.DatabaseCreateReplicaRequest) (*godo.DatabaseReplica, *godo.Response, error)
+	MockDeleteReplica func(context.Context, string, string) (*godo.Response, error)
+
+	MockUpdateFirewallRules func(context.Context, string, *godo.DatabaseUpdateFirewallRulesRequest) (*godo.Response, error)
+}
+
+// Get mocks Get method
+func (c *MockDatabasesService) Get(ctx context.Context, databaseID string) (*godo.Database, *godo.Response, error) {
+	return c.MockGet(ctx, databaseID)
+}
+
+// Resize mocks Resize method
+func (c *MockDatabasesService) Resize(ctx context.Context, databaseID string, resize *godo.DatabaseResizeRequest) (*godo.Response, error) {
+	return c.MockResize(ctx, databaseID, resize)
+}
+
+// Migrate mocks Migrate method
+func (c *MockDatabasesService) Migrate(ctx context.Context, databaseID string, migrate *godo.DatabaseMigrateRequest) (*godo.Response, error) {
+	return c.MockMigrate(ctx, databaseID, migrate)
+}
+
+// GetUser mocks GetUser method
+func (c *MockDatabasesService) GetUser(ctx context.Context, databaseID, userID string) (*godo.DatabaseUser, *godo.Response, error) {
+	return c.MockGetUser(ctx, databaseID, userID)
+}
+
+// CreateUser mocks CreateUser method
+func (c *MockDatabasesService) CreateUser(ctx context.Context, databaseID string, create *godo.DatabaseCreateUserRequest) (*godo.DatabaseUser, *godo.Response, error) {
+	return c.MockCreateUser(ctx, databaseID, create)
+}
+
+// DeleteUser mocks DeleteUser method
+func (c *MockDatabasesService) DeleteUser(ctx context.Context, databaseID, userID string) (*godo.Response, error) {
+	return c.MockDeleteUser(ctx, databaseID, userID)
+}
+
+// ResetUserAuth mocks ResetUserAuth method
+func (c *MockDatabasesService) ResetUserAuth(ctx context.Context, databaseID, userID string, reset *godo.DatabaseResetUserAuthRequest) (*godo.DatabaseUser, *godo.Response, error) {
+	return c.MockResetUserAuth(ctx, databaseID, userID, reset)
+}
+
+// CreateDB mocks CreateDB method
+func (c *MockDatabasesService) CreateDB(ctx context.Context, databaseID string, create *godo.DatabaseCreateDBRequest) (*godo.DatabaseDB, *godo.Response, error) {
+	return c.MockCreateDB(ctx, databaseID, create)
+}
+
+// GetDB mocks GetDB method
+func (c *MockDatabasesService) GetDB(ctx context.Context, databaseID, name string) (*godo.DatabaseDB, *godo.Response, error) {
+	return c.MockGetDB(ctx, databaseID, name)
+}
+
+// DeleteDB mocks DeleteDB method
+func (c *MockDatabasesService) DeleteDB(ctx context.Context, databaseID, name string) (*godo.Response, error) {
+	return c.MockDeleteDB(ctx, databaseID, name)
+}
+
+// CreatePool mocks CreatePool method
+func (c *MockDatabasesService) CreatePool(ctx context.Context, databaseID string, create *godo.DatabaseCreatePoolRequest) (*godo.DatabasePool, *godo.Response, error) {
+	return c.MockCreatePool(ctx, databaseID, create)
+}
+
+// GetPool mocks GetPool method
+func (c *MockDatabasesService) GetPool(ctx context.Context, databaseID, name string) (*godo.DatabasePool, *godo.Response, error) {
+	return c.MockGetPool(ctx, databaseID, name)
+}
+
+// DeletePool mocks DeletePool method
+func (c *MockDatabasesService) DeletePool(ctx context.Context, databaseID, name string) (*godo.Response, error) {
+	return c.MockDeletePool(ctx, databaseID, name)
+}
+
+// GetReplica mocks GetReplica method
+func (c *MockDatabasesService) GetReplica(ctx context.Context, databaseID, name string) (*godo.DatabaseReplica, *godo.Response, error) {
+	return c.MockGetReplica(ctx, databaseID, name)
+}
+
+// CreateReplica mocks CreateReplica method
+func (c *MockDatabasesService) CreateReplica(ctx context.Context, databaseID string, create *godo.DatabaseCreateReplicaRequest) (*godo.DatabaseReplica, *godo.Response, error) {
+	return c.MockCreateReplica(ctx, databaseID, create)
+}
+
+// DeleteReplica mocks DeleteReplica method
+func (c *MockDatabasesService) DeleteReplica(ctx context.Context, databaseID, name string) (*godo.Response, error) {
+	return c.MockDeleteReplica(ctx, databaseID, name)
+}
+
+// UpdateFirewallRules mocks UpdateFirewallRules method
+func (c *MockDatabasesService) UpdateFirewallRules(ctx context.Context, databaseID string, update *godo.DatabaseUpdateFirewallRulesRequest) (*godo.Response, error) {
+	return c.MockUpdateFirewallRules(ctx, databaseID, update)
+}
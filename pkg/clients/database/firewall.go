@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+)
+
+// Firewall rule types of a Database Cluster.
+const (
+	FirewallRuleTypeIPAddr            = "ip_addr"
+	FirewallRuleTypeDroplet           = "droplet"
+	FirewallRuleTypeKubernetesCluster = "k8s"
+	FirewallRuleTypeTag               = "tag"
+	FirewallRuleTypeApp               = "app"
+
+	errTrustedSourceNotUnique = "trusted source %d must specify exactly one of ipAddr, dropletID, kubernetesClusterID, tag or appID"
+)
+
+// GenerateFirewallRules generates the firewall rules of a Database Cluster
+// from the supplied trusted sources.
+func GenerateFirewallRules(sources []v1alpha1.DODatabaseClusterTrustedSource) ([]*godo.DatabaseFirewallRule, error) {
+	rules := make([]*godo.DatabaseFirewallRule, 0, len(sources))
+	for i, s := range sources {
+		var rule *godo.DatabaseFirewallRule
+		for _, candidate := range []struct {
+			ruleType string
+			value    *string
+		}{
+			{ruleType: FirewallRuleTypeIPAddr, value: s.IPAddr},
+			{ruleType: FirewallRuleTypeDroplet, value: s.DropletID},
+			{ruleType: FirewallRuleTypeKubernetesCluster, value: s.KubernetesClusterID},
+			{ruleType: FirewallRuleTypeTag, value: s.Tag},
+			{ruleType: FirewallRuleTypeApp, value: s.AppID},
+		} {
+			if candidate.value == nil || *candidate.value == "" {
+				continue
+			}
+			if rule != nil {
+				return nil, errors.Errorf(errTrustedSourceNotUnique, i)
+			}
+			rule = &godo.DatabaseFirewallRule{Type: candidate.ruleType, Value: *candidate.value}
+		}
+		if rule == nil {
+			return nil, errors.Errorf(errTrustedSourceNotUnique, i)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// GenerateFirewallRuleObservations generates DODatabaseClusterFirewallRule
+// instances from the supplied godo.DatabaseFirewallRules.
+func GenerateFirewallRuleObservations(rules []godo.DatabaseFirewallRule) []v1alpha1.DODatabaseClusterFirewallRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	o := make([]v1alpha1.DODatabaseClusterFirewallRule, len(rules))
+	for i, r := range rules {
+		o[i] = v1alpha1.DODatabaseClusterFirewallRule{
+			UUID:      r.UUID,
+			Type:      r.Type,
+			Value:     r.Value,
+			CreatedAt: r.CreatedAt.String(),
+		}
+	}
+	return o
+}
+
+// FirewallRulesUpToDate returns true if the supplied observed firewall rules
+// allow exactly the sources of the supplied desired firewall rules.
+func FirewallRulesUpToDate(desired []*godo.DatabaseFirewallRule, observed []godo.DatabaseFirewallRule) bool {
+	type source struct{ ruleType, value string }
+
+	want := map[source]bool{}
+	for _, r := range desired {
+		want[source{r.Type, r.Value}] = true
+	}
+	got := map[source]bool{}
+	for _, r := range observed {
+		got[source{r.Type, r.Value}] = true
+	}
+	if len(want) != len(got) {
+		return false
+	}
+	for s := range want {
+		if !got[s] {
+			return false
+		}
+	}
+	return true
+}
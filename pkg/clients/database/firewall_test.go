@@ -0,0 +1,145 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+)
+
+// An empty list of trusted sources removes all of them, so it must survive
+// being written back by the controller, while omitted ones stay unmanaged.
+func TestTrustedSourcesRoundTrip(t *testing.T) {
+	tests := map[string]struct {
+		sources []v1alpha1.DODatabaseClusterTrustedSource
+		wantNil bool
+	}{
+		"Unmanaged": {
+			sources: nil,
+			wantNil: true,
+		},
+		"NoTrustedSources": {
+			sources: []v1alpha1.DODatabaseClusterTrustedSource{},
+			wantNil: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			b, err := json.Marshal(v1alpha1.DODatabaseClusterParameters{TrustedSources: tc.sources})
+			assert.NoError(t, err)
+			got := v1alpha1.DODatabaseClusterParameters{}
+			assert.NoError(t, json.Unmarshal(b, &got))
+			assert.Equal(t, tc.wantNil, got.TrustedSources == nil)
+		})
+	}
+}
+
+func TestGenerateFirewallRules(t *testing.T) {
+	ip := "192.0.2.10"
+	droplet := "1234"
+	empty := ""
+	tests := map[string]struct {
+		sources []v1alpha1.DODatabaseClusterTrustedSource
+		want    []*godo.DatabaseFirewallRule
+		wantErr bool
+	}{
+		"Empty": {
+			sources: []v1alpha1.DODatabaseClusterTrustedSource{},
+			want:    []*godo.DatabaseFirewallRule{},
+		},
+		"Sources": {
+			sources: []v1alpha1.DODatabaseClusterTrustedSource{{IPAddr: &ip}, {DropletID: &droplet}},
+			want: []*godo.DatabaseFirewallRule{
+				{Type: FirewallRuleTypeIPAddr, Value: ip},
+				{Type: FirewallRuleTypeDroplet, Value: droplet},
+			},
+		},
+		"NoSource": {
+			sources: []v1alpha1.DODatabaseClusterTrustedSource{{}},
+			wantErr: true,
+		},
+		"EmptySource": {
+			sources: []v1alpha1.DODatabaseClusterTrustedSource{{Tag: &empty}},
+			wantErr: true,
+		},
+		"MultipleSources": {
+			sources: []v1alpha1.DODatabaseClusterTrustedSource{{IPAddr: &ip, DropletID: &droplet}},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateFirewallRules(tc.sources)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestGenerateFirewallRuleObservations(t *testing.T) {
+	created := time.Date(2021, time.October, 20, 12, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		rules []godo.DatabaseFirewallRule
+		want  []v1alpha1.DODatabaseClusterFirewallRule
+	}{
+		"None": {
+			rules: nil,
+			want:  nil,
+		},
+		"Rules": {
+			rules: []godo.DatabaseFirewallRule{{UUID: "uuid", Type: FirewallRuleTypeTag, Value: "web", CreatedAt: created}},
+			want:  []v1alpha1.DODatabaseClusterFirewallRule{{UUID: "uuid", Type: FirewallRuleTypeTag, Value: "web", CreatedAt: created.String()}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GenerateFirewallRuleObservations(tc.rules))
+		})
+	}
+}
+
+func TestFirewallRulesUpToDate(t *testing.T) {
+	desired := []*godo.DatabaseFirewallRule{
+		{Type: FirewallRuleTypeIPAddr, Value: "192.0.2.10"},
+		{Type: FirewallRuleTypeTag, Value: "web"},
+	}
+	tests := map[string]struct {
+		observed []godo.DatabaseFirewallRule
+		want     bool
+	}{
+		"UpToDate": {
+			observed: []godo.DatabaseFirewallRule{
+				{UUID: "b", Type: FirewallRuleTypeTag, Value: "web"},
+				{UUID: "a", Type: FirewallRuleTypeIPAddr, Value: "192.0.2.10"},
+			},
+			want: true,
+		},
+		"Missing": {
+			observed: []godo.DatabaseFirewallRule{{Type: FirewallRuleTypeTag, Value: "web"}},
+			want:     false,
+		},
+		"Extra": {
+			observed: []godo.DatabaseFirewallRule{
+				{Type: FirewallRuleTypeTag, Value: "web"},
+				{Type: FirewallRuleTypeTag, Value: "db"},
+			},
+			want: false,
+		},
+		"Changed": {
+			observed: []godo.DatabaseFirewallRule{
+				{Type: FirewallRuleTypeIPAddr, Value: "192.0.2.11"},
+				{Type: FirewallRuleTypeTag, Value: "web"},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, FirewallRulesUpToDate(desired, tc.observed))
+		})
+	}
+}
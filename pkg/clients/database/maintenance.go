@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+)
+
+const (
+	databaseInstallUpdatePath = "/v2/databases/%s/install_update"
+
+	// MaintenanceWindowDuration is the duration of the maintenance window of
+	// a Database Cluster, which starts at its configured day and hour.
+	MaintenanceWindowDuration = 4 * time.Hour
+)
+
+// InstallUpdate starts the installation of the pending maintenance updates of
+// the Database Cluster with the supplied ID.
+func InstallUpdate(ctx context.Context, client *godo.Client, clusterID string) (*godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodPut, fmt.Sprintf(databaseInstallUpdatePath, clusterID), nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(ctx, req, nil)
+}
+
+// InstallUpdateDue returns true if pending maintenance updates of a Database
+// Cluster should be installed at the supplied time.
+func InstallUpdateDue(p v1alpha1.DODatabaseClusterParameters, w v1alpha1.DODatabaseClusterMaintenanceWindow, now time.Time) bool {
+	if !w.Pending {
+		return false
+	}
+	switch do.StringValue(p.InstallUpdates) {
+	case v1alpha1.InstallUpdatesImmediately:
+		return true
+	case v1alpha1.InstallUpdatesInMaintenanceWindow:
+		return InMaintenanceWindow(w, now)
+	default:
+		return false
+	}
+}
+
+// InMaintenanceWindow returns true if the supplied time is within the supplied
+// maintenance window.
+func InMaintenanceWindow(w v1alpha1.DODatabaseClusterMaintenanceWindow, now time.Time) bool {
+	hour, err := time.Parse("15:04", parseHour(w.Hour))
+	if err != nil {
+		return false
+	}
+	day := -1
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), w.Day) {
+			day = int(d)
+		}
+	}
+	if day < 0 {
+		return false
+	}
+
+	now = now.UTC()
+	offset := (int(now.Weekday()) - day + 7) % 7
+	start := time.Date(now.Year(), now.Month(), now.Day()-offset, hour.Hour(), hour.Minute(), 0, 0, time.UTC)
+	if start.After(now) {
+		start = start.AddDate(0, 0, -7)
+	}
+	return now.Sub(start) < MaintenanceWindowDuration
+}
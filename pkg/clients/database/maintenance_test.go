@@ -0,0 +1,138 @@
+package database
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+)
+
+func TestInstallUpdate(t *testing.T) {
+	var method, path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := godo.New(server.Client(), godo.SetBaseURL(server.URL))
+	assert.NoError(t, err)
+
+	_, err = InstallUpdate(context.Background(), client, "cluster")
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/v2/databases/cluster/install_update", path)
+}
+
+func TestInstallUpdateDue(t *testing.T) {
+	immediately := v1alpha1.InstallUpdatesImmediately
+	inWindow := v1alpha1.InstallUpdatesInMaintenanceWindow
+	pending := v1alpha1.DODatabaseClusterMaintenanceWindow{Day: "tuesday", Hour: "14:00:00", Pending: true}
+	// Tuesday, 7 September 2021.
+	tuesday := func(hour, minute int) time.Time { return time.Date(2021, 9, 7, hour, minute, 0, 0, time.UTC) }
+	tests := map[string]struct {
+		params v1alpha1.DODatabaseClusterParameters
+		window v1alpha1.DODatabaseClusterMaintenanceWindow
+		now    time.Time
+		want   bool
+	}{
+		"NotPending": {
+			params: v1alpha1.DODatabaseClusterParameters{InstallUpdates: &immediately},
+			window: v1alpha1.DODatabaseClusterMaintenanceWindow{Day: "tuesday", Hour: "14:00:00"},
+			now:    tuesday(15, 0),
+			want:   false,
+		},
+		"NotOptedIn": {
+			window: pending,
+			now:    tuesday(15, 0),
+			want:   false,
+		},
+		"Immediately": {
+			params: v1alpha1.DODatabaseClusterParameters{InstallUpdates: &immediately},
+			window: pending,
+			now:    tuesday(9, 0),
+			want:   true,
+		},
+		"BeforeWindow": {
+			params: v1alpha1.DODatabaseClusterParameters{InstallUpdates: &inWindow},
+			window: pending,
+			now:    tuesday(13, 59),
+			want:   false,
+		},
+		"InWindow": {
+			params: v1alpha1.DODatabaseClusterParameters{InstallUpdates: &inWindow},
+			window: pending,
+			now:    tuesday(17, 30),
+			want:   true,
+		},
+		"AfterWindow": {
+			params: v1alpha1.DODatabaseClusterParameters{InstallUpdates: &inWindow},
+			window: pending,
+			now:    tuesday(18, 0),
+			want:   false,
+		},
+		"WindowAcrossMidnight": {
+			params: v1alpha1.DODatabaseClusterParameters{InstallUpdates: &inWindow},
+			window: v1alpha1.DODatabaseClusterMaintenanceWindow{Day: "monday", Hour: "22:00", Pending: true},
+			now:    tuesday(1, 0),
+			want:   true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, InstallUpdateDue(tc.params, tc.window, tc.now))
+		})
+	}
+}
+
+func TestInMaintenanceWindow(t *testing.T) {
+	// Saturday, 11 September 2021.
+	saturday := func(hour, minute int) time.Time { return time.Date(2021, 9, 11, hour, minute, 0, 0, time.UTC) }
+	tests := map[string]struct {
+		window v1alpha1.DODatabaseClusterMaintenanceWindow
+		now    time.Time
+		want   bool
+	}{
+		"InvalidHour": {
+			window: v1alpha1.DODatabaseClusterMaintenanceWindow{Day: "saturday", Hour: "noon"},
+			now:    saturday(12, 0),
+			want:   false,
+		},
+		"InvalidDay": {
+			window: v1alpha1.DODatabaseClusterMaintenanceWindow{Day: "someday", Hour: "12:00"},
+			now:    saturday(12, 0),
+			want:   false,
+		},
+		"Start": {
+			window: v1alpha1.DODatabaseClusterMaintenanceWindow{Day: "Saturday", Hour: "12:00:00"},
+			now:    saturday(12, 0),
+			want:   true,
+		},
+		"End": {
+			window: v1alpha1.DODatabaseClusterMaintenanceWindow{Day: "saturday", Hour: "12:00"},
+			now:    saturday(16, 0),
+			want:   false,
+		},
+		"AcrossWeek": {
+			window: v1alpha1.DODatabaseClusterMaintenanceWindow{Day: "saturday", Hour: "22:00"},
+			now:    saturday(22, 0).Add(3 * time.Hour),
+			want:   true,
+		},
+		"OtherTimeZone": {
+			window: v1alpha1.DODatabaseClusterMaintenanceWindow{Day: "saturday", Hour: "12:00"},
+			now:    saturday(12, 30).In(time.FixedZone("UTC-10", -10*60*60)),
+			want:   true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, InMaintenanceWindow(tc.window, tc.now))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"github.com/digitalocean/godo"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+)
+
+// GenerateCreateReplicaRequest generates *godo.DatabaseCreateReplicaRequest instance from DODatabaseReplicaParameters.
+// The replica is placed in the supplied region of its Database Cluster unless a region is specified.
+func GenerateCreateReplicaRequest(name string, in v1alpha1.DODatabaseReplicaParameters, clusterRegion string) *godo.DatabaseCreateReplicaRequest {
+	region := do.StringValue(in.Region)
+	if region == "" {
+		region = clusterRegion
+	}
+	return &godo.DatabaseCreateReplicaRequest{
+		Name:               name,
+		Region:             region,
+		Size:               in.Size,
+		PrivateNetworkUUID: do.StringValue(in.PrivateNetworkUUID),
+		Tags:               in.Tags,
+	}
+}
+
+// GenerateReplicaObservation generates DODatabaseReplicaObservation instance from godo.DatabaseReplica.
+func GenerateReplicaObservation(replica *godo.DatabaseReplica) v1alpha1.DODatabaseReplicaObservation {
+	return v1alpha1.DODatabaseReplicaObservation{
+		Name:               replica.Name,
+		Region:             replica.Region,
+		Status:             replica.Status,
+		CreatedAt:          replica.CreatedAt.String(),
+		PrivateNetworkUUID: replica.PrivateNetworkUUID,
+		Tags:               replica.Tags,
+	}
+}
+
+// ReplicaLateInitializeSpec updates any unset (i.e. nil) optional fields of the
+// supplied DODatabaseReplicaParameters that are set (i.e. non-zero) on the
+// supplied replica.
+func ReplicaLateInitializeSpec(p *v1alpha1.DODatabaseReplicaParameters, observed godo.DatabaseReplica) {
+	p.Region = do.LateInitializeString(p.Region, observed.Region)
+	p.PrivateNetworkUUID = do.LateInitializeString(p.PrivateNetworkUUID, observed.PrivateNetworkUUID)
+
+	if len(p.Tags) == 0 && len(observed.Tags) != 0 {
+		p.Tags = make([]string, len(observed.Tags))
+		copy(p.Tags, observed.Tags)
+	}
+}
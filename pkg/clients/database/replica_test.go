@@ -0,0 +1,65 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+)
+
+func TestGenerateCreateReplicaRequest(t *testing.T) {
+	region := "ams3"
+	vpc := "vpc"
+	tests := map[string]struct {
+		params v1alpha1.DODatabaseReplicaParameters
+		want   *godo.DatabaseCreateReplicaRequest
+	}{
+		"RegionOfCluster": {
+			params: v1alpha1.DODatabaseReplicaParameters{Size: "db-s-1vcpu-1gb"},
+			want:   &godo.DatabaseCreateReplicaRequest{Name: "replica", Region: "nyc3", Size: "db-s-1vcpu-1gb"},
+		},
+		"RegionSpecified": {
+			params: v1alpha1.DODatabaseReplicaParameters{Region: &region, Size: "db-s-1vcpu-1gb", PrivateNetworkUUID: &vpc, Tags: []string{"a"}},
+			want:   &godo.DatabaseCreateReplicaRequest{Name: "replica", Region: region, Size: "db-s-1vcpu-1gb", PrivateNetworkUUID: vpc, Tags: []string{"a"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GenerateCreateReplicaRequest("replica", tc.params, "nyc3"))
+		})
+	}
+}
+
+func TestReplicaLateInitializeSpec(t *testing.T) {
+	region := "ams3"
+	observed := godo.DatabaseReplica{Region: "nyc3", PrivateNetworkUUID: "vpc", Tags: []string{"a"}}
+	tests := map[string]struct {
+		params v1alpha1.DODatabaseReplicaParameters
+		want   v1alpha1.DODatabaseReplicaParameters
+	}{
+		"Unset": {
+			params: v1alpha1.DODatabaseReplicaParameters{},
+			want: v1alpha1.DODatabaseReplicaParameters{
+				Region:             &observed.Region,
+				PrivateNetworkUUID: &observed.PrivateNetworkUUID,
+				Tags:               []string{"a"},
+			},
+		},
+		"Set": {
+			params: v1alpha1.DODatabaseReplicaParameters{Region: &region, Tags: []string{"b"}},
+			want: v1alpha1.DODatabaseReplicaParameters{
+				Region:             &region,
+				PrivateNetworkUUID: &observed.PrivateNetworkUUID,
+				Tags:               []string{"b"},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ReplicaLateInitializeSpec(&tc.params, observed)
+			assert.Equal(t, tc.want, tc.params)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+)
+
+const (
+	errSettingsEngineMismatch = "%s settings are not supported by Database Clusters of engine %q"
+)
+
+// ValidateEngineSettings returns an error if the supplied parameters contain
+// settings of an engine other than the engine of the Database Cluster.
+func ValidateEngineSettings(p v1alpha1.DODatabaseClusterParameters) error {
+	engine := do.StringValue(p.Engine)
+	if p.Redis != nil && engine != v1alpha1.EngineRedis {
+		return errors.Errorf(errSettingsEngineMismatch, "redis", engine)
+	}
+	if p.MySQL != nil && engine != v1alpha1.EngineMySQL {
+		return errors.Errorf(errSettingsEngineMismatch, "mysql", engine)
+	}
+	return nil
+}
+
+// DesiredEvictionPolicy returns the desired eviction policy of a Redis
+// Database Cluster, or an empty string if it is not managed.
+func DesiredEvictionPolicy(p v1alpha1.DODatabaseClusterParameters) string {
+	if p.Redis == nil {
+		return ""
+	}
+	return do.StringValue(p.Redis.EvictionPolicy)
+}
+
+// ParseSQLModes parses the comma separated SQL modes of a MySQL Database
+// Cluster.
+func ParseSQLModes(modes string) []string {
+	if modes == "" {
+		return nil
+	}
+	parsed := strings.Split(modes, ",")
+	for i := range parsed {
+		parsed[i] = strings.TrimSpace(parsed[i])
+	}
+	return parsed
+}
+
+// SQLModesUpToDate returns true if the SQL modes of a MySQL Database Cluster
+// are not managed or match the supplied observed SQL modes, regardless of
+// their order and case.
+func SQLModesUpToDate(p v1alpha1.DODatabaseClusterParameters, observed []string) bool {
+	if p.MySQL == nil || p.MySQL.SQLModes == nil {
+		return true
+	}
+	return strings.Join(normalizeSQLModes(p.MySQL.SQLModes), ",") == strings.Join(normalizeSQLModes(observed), ",")
+}
+
+func normalizeSQLModes(modes []string) []string {
+	n := make([]string, len(modes))
+	for i, m := range modes {
+		n[i] = strings.ToUpper(strings.TrimSpace(m))
+	}
+	sort.Strings(n)
+	return n
+}
@@ -0,0 +1,118 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+)
+
+func TestValidateEngineSettings(t *testing.T) {
+	redis := v1alpha1.EngineRedis
+	mysql := v1alpha1.EngineMySQL
+	policy := "allkeys_lru"
+	tests := map[string]struct {
+		params  v1alpha1.DODatabaseClusterParameters
+		wantErr bool
+	}{
+		"NoSettings": {
+			params: v1alpha1.DODatabaseClusterParameters{Engine: &redis},
+		},
+		"RedisSettings": {
+			params: v1alpha1.DODatabaseClusterParameters{Engine: &redis, Redis: &v1alpha1.DODatabaseClusterRedisParameters{EvictionPolicy: &policy}},
+		},
+		"MySQLSettings": {
+			params: v1alpha1.DODatabaseClusterParameters{Engine: &mysql, MySQL: &v1alpha1.DODatabaseClusterMySQLParameters{SQLModes: []string{"ANSI"}}},
+		},
+		"RedisSettingsOfMySQL": {
+			params:  v1alpha1.DODatabaseClusterParameters{Engine: &mysql, Redis: &v1alpha1.DODatabaseClusterRedisParameters{EvictionPolicy: &policy}},
+			wantErr: true,
+		},
+		"MySQLSettingsOfRedis": {
+			params:  v1alpha1.DODatabaseClusterParameters{Engine: &redis, MySQL: &v1alpha1.DODatabaseClusterMySQLParameters{}},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.wantErr, ValidateEngineSettings(tc.params) != nil)
+		})
+	}
+}
+
+func TestSQLModesUpToDate(t *testing.T) {
+	tests := map[string]struct {
+		params   v1alpha1.DODatabaseClusterParameters
+		observed string
+		want     bool
+	}{
+		"Unmanaged": {
+			params:   v1alpha1.DODatabaseClusterParameters{},
+			observed: "ANSI",
+			want:     true,
+		},
+		"UpToDate": {
+			params:   v1alpha1.DODatabaseClusterParameters{MySQL: &v1alpha1.DODatabaseClusterMySQLParameters{SQLModes: []string{"strict_all_tables", "ANSI"}}},
+			observed: "ANSI,STRICT_ALL_TABLES",
+			want:     true,
+		},
+		"Changed": {
+			params:   v1alpha1.DODatabaseClusterParameters{MySQL: &v1alpha1.DODatabaseClusterMySQLParameters{SQLModes: []string{"ANSI"}}},
+			observed: "ANSI,STRICT_ALL_TABLES",
+			want:     false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, SQLModesUpToDate(tc.params, ParseSQLModes(tc.observed)))
+		})
+	}
+}
+
+func TestDesiredEvictionPolicy(t *testing.T) {
+	policy := "allkeys_lru"
+	tests := map[string]struct {
+		params v1alpha1.DODatabaseClusterParameters
+		want   string
+	}{
+		"Unmanaged": {
+			params: v1alpha1.DODatabaseClusterParameters{},
+			want:   "",
+		},
+		"NoPolicy": {
+			params: v1alpha1.DODatabaseClusterParameters{Redis: &v1alpha1.DODatabaseClusterRedisParameters{}},
+			want:   "",
+		},
+		"Policy": {
+			params: v1alpha1.DODatabaseClusterParameters{Redis: &v1alpha1.DODatabaseClusterRedisParameters{EvictionPolicy: &policy}},
+			want:   policy,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, DesiredEvictionPolicy(tc.params))
+		})
+	}
+}
+
+func TestParseSQLModes(t *testing.T) {
+	tests := map[string]struct {
+		modes string
+		want  []string
+	}{
+		"Empty": {
+			modes: "",
+			want:  nil,
+		},
+		"Modes": {
+			modes: "ANSI, STRICT_ALL_TABLES",
+			want:  []string{"ANSI", "STRICT_ALL_TABLES"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ParseSQLModes(tc.modes))
+		})
+	}
+}
@@ -127,6 +127,14 @@ func LateInitializeInt64(i *int64, from int64) *int64 {
 	return &from
 }
 
+// LateInitializeInt implements late initialization for int type.
+func LateInitializeInt(i *int, from int) *int {
+	if i != nil || from == 0 {
+		return i
+	}
+	return &from
+}
+
 // LateInitializeBool implements late initialization for bool type.
 func LateInitializeBool(b *bool, from bool) *bool {
 	if b != nil || !from {
@@ -165,3 +173,23 @@ func IgnoreNotFound(err error, response *godo.Response) error {
 	}
 	return err
 }
+
+// RecordValidation records the outcome of validating the parameters of the
+// supplied resource in its InvalidParameters condition, and returns the
+// validation error wrapped with the supplied message. The condition is only
+// reset once it was set, so that resources that never had invalid parameters
+// don't carry it.
+//
+// Resources validate their parameters in Observe before their external
+// resource exists, rather than only in Create, because the managed reconciler
+// does not persist the status of a resource after Create.
+func RecordValidation(cr resource.Conditioned, err error, msg string) error {
+	if err != nil {
+		cr.SetConditions(v1alpha1.ParametersRejected(err))
+		return errors.Wrap(err, msg)
+	}
+	if cr.GetCondition(v1alpha1.TypeInvalidParameters).Reason != "" {
+		cr.SetConditions(v1alpha1.ParametersAccepted())
+	}
+	return nil
+}
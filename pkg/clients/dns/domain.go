@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"sort"
+
+	"github.com/digitalocean/godo"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/dns/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+)
+
+const (
+	// apexName is the record name that refers to the Domain itself.
+	apexName = "@"
+)
+
+// GenerateDomain generates *godo.DomainCreateRequest instance from
+// DomainParameters.
+func GenerateDomain(name string, in v1alpha1.DomainParameters) *godo.DomainCreateRequest {
+	return &godo.DomainCreateRequest{
+		Name:      name,
+		IPAddress: do.StringValue(in.IPAddress),
+	}
+}
+
+// GenerateDomainObservation generates a DomainObservation from the supplied
+// godo.Domain and its NS records.
+func GenerateDomainObservation(observed godo.Domain, ns []godo.DomainRecord) v1alpha1.DomainObservation {
+	o := v1alpha1.DomainObservation{
+		Name:     observed.Name,
+		URN:      observed.URN(),
+		TTL:      observed.TTL,
+		ZoneFile: observed.ZoneFile,
+	}
+	for _, r := range ns {
+		if r.Type == v1alpha1.RecordTypeNS && r.Name == apexName {
+			o.NameServers = append(o.NameServers, r.Data)
+		}
+	}
+	sort.Strings(o.NameServers)
+	return o
+}
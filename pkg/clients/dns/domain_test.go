@@ -0,0 +1,27 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/dns/v1alpha1"
+)
+
+func TestGenerateDomainObservation(t *testing.T) {
+	observed := godo.Domain{Name: "example.com", TTL: 1800, ZoneFile: "mock-zone-file"}
+	ns := []godo.DomainRecord{
+		{Type: v1alpha1.RecordTypeNS, Name: "@", Data: "ns2.digitalocean.com"},
+		{Type: v1alpha1.RecordTypeNS, Name: "@", Data: "ns1.digitalocean.com"},
+		{Type: v1alpha1.RecordTypeNS, Name: "sub", Data: "ns1.example.net"},
+	}
+
+	assert.Equal(t, v1alpha1.DomainObservation{
+		Name:        "example.com",
+		URN:         "do:domain:example.com",
+		TTL:         1800,
+		NameServers: []string{"ns1.digitalocean.com", "ns2.digitalocean.com"},
+		ZoneFile:    "mock-zone-file",
+	}, GenerateDomainObservation(observed, ns))
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"net"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/dns/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+)
+
+const (
+	errMissingDomain = "the domain of the record is not set"
+	errMissingData   = "the data of the record is not set"
+)
+
+// ValidateDomainRecord returns an error if the supplied
+// DomainRecordParameters cannot be used to create or edit a record, e.g.
+// because their references were not resolved.
+func ValidateDomainRecord(p v1alpha1.DomainRecordParameters) error {
+	if p.Domain == "" {
+		return errors.New(errMissingDomain)
+	}
+	if do.StringValue(p.Data) == "" {
+		return errors.New(errMissingData)
+	}
+	return nil
+}
+
+// GenerateDomainRecord generates *godo.DomainRecordEditRequest instance from
+// DomainRecordParameters.
+func GenerateDomainRecord(in v1alpha1.DomainRecordParameters) *godo.DomainRecordEditRequest {
+	return &godo.DomainRecordEditRequest{
+		Type:     in.Type,
+		Name:     in.Name,
+		Data:     do.StringValue(in.Data),
+		Priority: do.IntValue(in.Priority),
+		Port:     do.IntValue(in.Port),
+		Weight:   do.IntValue(in.Weight),
+		Flags:    do.IntValue(in.Flags),
+		Tag:      do.StringValue(in.Tag),
+		TTL:      do.IntValue(in.TTL),
+	}
+}
+
+// GenerateDomainRecordObservation generates a DomainRecordObservation from
+// the supplied godo.DomainRecord.
+func GenerateDomainRecordObservation(observed godo.DomainRecord) v1alpha1.DomainRecordObservation {
+	return v1alpha1.DomainRecordObservation{
+		ID:       observed.ID,
+		Type:     observed.Type,
+		Name:     observed.Name,
+		Data:     observed.Data,
+		Priority: observed.Priority,
+		Port:     observed.Port,
+		Weight:   observed.Weight,
+		Flags:    observed.Flags,
+		Tag:      observed.Tag,
+		TTL:      observed.TTL,
+	}
+}
+
+// DomainRecordLateInitializeSpec updates any unset (i.e. nil) optional fields
+// of the supplied DomainRecordParameters that are set (i.e. non-zero) on the
+// supplied record.
+func DomainRecordLateInitializeSpec(p *v1alpha1.DomainRecordParameters, observed godo.DomainRecord) {
+	p.Data = do.LateInitializeString(p.Data, observed.Data)
+	p.Priority = do.LateInitializeInt(p.Priority, observed.Priority)
+	p.Port = do.LateInitializeInt(p.Port, observed.Port)
+	p.Weight = do.LateInitializeInt(p.Weight, observed.Weight)
+	p.Flags = do.LateInitializeInt(p.Flags, observed.Flags)
+	p.Tag = do.LateInitializeString(p.Tag, observed.Tag)
+	p.TTL = do.LateInitializeInt(p.TTL, observed.TTL)
+}
+
+// DomainRecordIsUpToDate returns true if the supplied record matches the
+// supplied DomainRecordParameters. Host names are compared regardless of
+// case, trailing dots and whether they are relative to the Domain.
+func DomainRecordIsUpToDate(p v1alpha1.DomainRecordParameters, observed godo.DomainRecord) bool {
+	return normalizeHost(p.Name, p.Domain) == normalizeHost(observed.Name, p.Domain) &&
+		(p.Data == nil || normalizeData(p.Type, *p.Data, p.Domain) == normalizeData(p.Type, observed.Data, p.Domain)) &&
+		(p.Priority == nil || *p.Priority == observed.Priority) &&
+		(p.Port == nil || *p.Port == observed.Port) &&
+		(p.Weight == nil || *p.Weight == observed.Weight) &&
+		(p.Flags == nil || *p.Flags == observed.Flags) &&
+		(p.Tag == nil || *p.Tag == observed.Tag) &&
+		(p.TTL == nil || *p.TTL == observed.TTL)
+}
+
+// normalizeData returns the data of a record of the supplied type in the
+// supplied domain in a form that can be compared.
+func normalizeData(recordType, data, domain string) string {
+	switch recordType {
+	case v1alpha1.RecordTypeA, v1alpha1.RecordTypeAAAA:
+		if ip := net.ParseIP(data); ip != nil {
+			return ip.String()
+		}
+	case v1alpha1.RecordTypeCNAME, v1alpha1.RecordTypeMX, v1alpha1.RecordTypeNS, v1alpha1.RecordTypeSRV:
+		return normalizeHost(data, domain)
+	}
+	return data
+}
+
+// normalizeHost returns the supplied host name relative to the supplied
+// domain, or "@" for the domain itself. Host names outside of the domain are
+// returned without a trailing dot.
+func normalizeHost(host, domain string) string {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if host == "" || host == domain {
+		return apexName
+	}
+	return strings.TrimSuffix(host, "."+domain)
+}
@@ -0,0 +1,60 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/dns/v1alpha1"
+)
+
+func TestDomainRecordIsUpToDate(t *testing.T) {
+	ttl := 3600
+	priority := 10
+	tests := map[string]struct {
+		params   v1alpha1.DomainRecordParameters
+		observed godo.DomainRecord
+		want     bool
+	}{
+		"A": {
+			params:   v1alpha1.DomainRecordParameters{Domain: "example.com", Type: "A", Name: "www", Data: strPtr("192.0.2.10"), TTL: &ttl},
+			observed: godo.DomainRecord{Type: "A", Name: "www", Data: "192.0.2.10", TTL: 3600},
+			want:     true,
+		},
+		"AChanged": {
+			params:   v1alpha1.DomainRecordParameters{Domain: "example.com", Type: "A", Name: "www", Data: strPtr("192.0.2.11")},
+			observed: godo.DomainRecord{Type: "A", Name: "www", Data: "192.0.2.10", TTL: 3600},
+			want:     false,
+		},
+		"AAAA": {
+			params:   v1alpha1.DomainRecordParameters{Domain: "example.com", Type: "AAAA", Name: "@", Data: strPtr("2001:DB8:0:0::1")},
+			observed: godo.DomainRecord{Type: "AAAA", Name: "@", Data: "2001:db8::1"},
+			want:     true,
+		},
+		"CNAME": {
+			params:   v1alpha1.DomainRecordParameters{Domain: "example.com", Type: "CNAME", Name: "www.example.com.", Data: strPtr("example.com.")},
+			observed: godo.DomainRecord{Type: "CNAME", Name: "www", Data: "@"},
+			want:     true,
+		},
+		"MX": {
+			params:   v1alpha1.DomainRecordParameters{Domain: "example.com", Type: "MX", Name: "@", Data: strPtr("mx.example.net."), Priority: &priority},
+			observed: godo.DomainRecord{Type: "MX", Name: "@", Data: "mx.example.net", Priority: 10},
+			want:     true,
+		},
+		"TTLChanged": {
+			params:   v1alpha1.DomainRecordParameters{Domain: "example.com", Type: "TXT", Name: "@", Data: strPtr("v=spf1 -all"), TTL: &ttl},
+			observed: godo.DomainRecord{Type: "TXT", Name: "@", Data: "v=spf1 -all", TTL: 1800},
+			want:     false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, DomainRecordIsUpToDate(tc.params, tc.observed))
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
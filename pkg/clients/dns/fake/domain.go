@@ -0,0 +1,66 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+)
+
+// this ensures that the mock implements the client interface
+var _ godo.DomainsService = (*MockDomainsService)(nil)
+
+// MockDomainsService is a type that implements the methods of
+// godo.DomainsService used by the controllers of the dns API group. Calling
+// any other method panics.
+type MockDomainsService struct {
+	godo.DomainsService
+
+	MockGet           func(context.Context, string) (*godo.Domain, *godo.Response, error)
+	MockCreate        func(context.Context, *godo.DomainCreateRequest) (*godo.Domain, *godo.Response, error)
+	MockDelete        func(context.Context, string) (*godo.Response, error)
+	MockRecordsByType func(context.Context, string, string, *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error)
+	MockRecord        func(context.Context, string, int) (*godo.DomainRecord, *godo.Response, error)
+	MockCreateRecord  func(context.Context, string, *godo.DomainRecordEditRequest) (*godo.DomainRecord, *godo.Response, error)
+	MockEditRecord    func(context.Context, string, int, *godo.DomainRecordEditRequest) (*godo.DomainRecord, *godo.Response, error)
+	MockDeleteRecord  func(context.Context, string, int) (*godo.Response, error)
+}
+
+// Get mocks Get method
+func (c *MockDomainsService) Get(ctx context.Context, name string) (*godo.Domain, *godo.Response, error) {
+	return c.MockGet(ctx, name)
+}
+
+// Create mocks Create method
+func (c *MockDomainsService) Create(ctx context.Context, create *godo.DomainCreateRequest) (*godo.Domain, *godo.Response, error) {
+	return c.MockCreate(ctx, create)
+}
+
+// Delete mocks Delete method
+func (c *MockDomainsService) Delete(ctx context.Context, name string) (*godo.Response, error) {
+	return c.MockDelete(ctx, name)
+}
+
+// RecordsByType mocks RecordsByType method
+func (c *MockDomainsService) RecordsByType(ctx context.Context, domain, recordType string, opt *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	return c.MockRecordsByType(ctx, domain, recordType, opt)
+}
+
+// Record mocks Record method
+func (c *MockDomainsService) Record(ctx context.Context, domain string, id int) (*godo.DomainRecord, *godo.Response, error) {
+	return c.MockRecord(ctx, domain, id)
+}
+
+// CreateRecord mocks CreateRecord method
+func (c *MockDomainsService) CreateRecord(ctx context.Context, domain string, create *godo.DomainRecordEditRequest) (*godo.DomainRecord, *godo.Response, error) {
+	return c.MockCreateRecord(ctx, domain, create)
+}
+
+// EditRecord mocks EditRecord method
+func (c *MockDomainsService) EditRecord(ctx context.Context, domain string, id int, edit *godo.DomainRecordEditRequest) (*godo.DomainRecord, *godo.Response, error) {
+	return c.MockEditRecord(ctx, domain, id, edit)
+}
+
+// DeleteRecord mocks DeleteRecord method
+func (c *MockDomainsService) DeleteRecord(ctx context.Context, domain string, id int) (*godo.Response, error) {
+	return c.MockDeleteRecord(ctx, domain, id)
+}
@@ -15,8 +15,16 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane-contrib/provider-digitalocean/apis/kubernetes/v1alpha1"
 	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
@@ -25,12 +33,47 @@ import (
 // RegistryClient is the external client used for DOContainerRegistry Custom Resource
 type RegistryClient interface {
 	Get(context.Context) (*godo.Registry, *godo.Response, error)
+	GetOptions(context.Context) (*godo.RegistryOptions, *godo.Response, error)
 	GetSubscription(context.Context) (*godo.RegistrySubscription, *godo.Response, error)
 	Create(context.Context, *godo.RegistryCreateRequest) (*godo.Registry, *godo.Response, error)
 	UpdateSubscription(context.Context, *godo.RegistrySubscriptionUpdateRequest) (*godo.RegistrySubscription, *godo.Response, error)
 	Delete(context.Context) (*godo.Response, error)
+	DockerCredentials(context.Context, *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, *godo.Response, error)
+	StartGarbageCollection(context.Context, string, ...*godo.StartGarbageCollectionRequest) (*godo.GarbageCollection, *godo.Response, error)
+	GetGarbageCollection(context.Context, string) (*godo.GarbageCollection, *godo.Response, error)
+	ListGarbageCollections(context.Context, string, *godo.ListOptions) ([]*godo.GarbageCollection, *godo.Response, error)
+	ListRepositoriesV2(context.Context, string, *godo.TokenListOptions) ([]*godo.RepositoryV2, *godo.Response, error)
+	ListRepositoryTags(context.Context, string, string, *godo.ListOptions) ([]*godo.RepositoryTag, *godo.Response, error)
+	DeleteTag(context.Context, string, string, string) (*godo.Response, error)
+	DeleteManifest(context.Context, string, string, string) (*godo.Response, error)
 }
 
+// DefaultTagRetentionIntervalMinutes is the interval at which the repositories
+// of a registry are evaluated against its tag retention policy by default.
+const DefaultTagRetentionIntervalMinutes = 60
+
+// RepositoryCountIntervalMinutes is the interval at which the repositories of a
+// registry are counted to determine its usage.
+const RepositoryCountIntervalMinutes = 60
+
+// MaxExpiredTagSample is the maximum number of expired tags reported in the
+// status of a registry.
+const MaxExpiredTagSample = 20
+
+// Garbage collection statuses after which a garbage collection is no longer active.
+const (
+	GarbageCollectionStatusSucceeded = "succeeded"
+	GarbageCollectionStatusFailed    = "failed"
+	GarbageCollectionStatusCancelled = "cancelled"
+)
+
+const (
+	errTierNotAvailable     = "subscription tier %q is not available"
+	errRepositoriesExceeded = "%d repositories exceed the %d included in subscription tier %q"
+	errStorageUsageExceeded = "%d bytes of storage exceed the %d included in subscription tier %q"
+	errTierLimitsExceeded   = "cannot change to subscription tier %q: %s"
+)
+
 // GenerateContainerRegistry generates *godo.RegistryCreateRequest instance from DOContainerRegistryParameters.
 func GenerateContainerRegistry(name string, in v1alpha1.DOContainerRegistryParameters, create *godo.RegistryCreateRequest) {
 	create.Name = name
@@ -55,17 +98,291 @@ func GenerateContainerRegistryObservation(registry *godo.Registry, subscription
 		StorageUsageBytes:          registry.StorageUsageBytes,
 		StorageUsageBytesUpdatedAt: registry.StorageUsageBytesUpdatedAt.String(),
 		Subscription: v1alpha1.Subscription{
-			Tier: v1alpha1.Tier{
-				Name:                   subscription.Tier.Name,
-				Slug:                   subscription.Tier.Slug,
-				IncludedRepositories:   subscription.Tier.IncludedRepositories,
-				IncludedStorageBytes:   subscription.Tier.IncludedStorageBytes,
-				AllowStorageOverage:    subscription.Tier.AllowStorageOverage,
-				IncludedBandwidthBytes: subscription.Tier.IncludedBandwidthBytes,
-				MonthlyPriceInCents:    subscription.Tier.MonthlyPriceInCents,
-			},
+			Tier:      GenerateTier(subscription.Tier),
 			CreatedAt: subscription.CreatedAt.String(),
 			UpdatedAt: subscription.UpdatedAt.String(),
 		},
 	}
 }
+
+// GenerateTier generates Tier instance from godo.RegistrySubscriptionTier.
+func GenerateTier(tier *godo.RegistrySubscriptionTier) v1alpha1.Tier {
+	return v1alpha1.Tier{
+		Name:                   tier.Name,
+		Slug:                   tier.Slug,
+		IncludedRepositories:   tier.IncludedRepositories,
+		IncludedStorageBytes:   tier.IncludedStorageBytes,
+		AllowStorageOverage:    tier.AllowStorageOverage,
+		IncludedBandwidthBytes: tier.IncludedBandwidthBytes,
+		MonthlyPriceInCents:    tier.MonthlyPriceInCents,
+	}
+}
+
+// TierUsageExceeded returns a description of each way in which the supplied
+// usage exceeds what is included in the supplied subscription tier. Storage
+// above what is included is not reported for tiers that allow storage overage.
+func TierUsageExceeded(tier v1alpha1.Tier, storageUsageBytes, repositories uint64) []string {
+	var exceeded []string
+	if tier.IncludedRepositories > 0 && repositories > tier.IncludedRepositories {
+		exceeded = append(exceeded, fmt.Sprintf(errRepositoriesExceeded, repositories, tier.IncludedRepositories, tier.Slug))
+	}
+	if !tier.AllowStorageOverage && storageUsageBytes > tier.IncludedStorageBytes {
+		exceeded = append(exceeded, fmt.Sprintf(errStorageUsageExceeded, storageUsageBytes, tier.IncludedStorageBytes, tier.Slug))
+	}
+	return exceeded
+}
+
+// ValidateTierChange returns an error if a registry with the supplied usage
+// cannot be moved to the subscription tier with the supplied slug.
+func ValidateTierChange(slug string, options *godo.RegistryOptions, storageUsageBytes, repositories uint64) error {
+	var target *godo.RegistrySubscriptionTier
+	if options != nil {
+		for _, t := range options.SubscriptionTiers {
+			if t.Slug == slug {
+				target = t
+				break
+			}
+		}
+	}
+	if target == nil {
+		return errors.Errorf(errTierNotAvailable, slug)
+	}
+
+	if exceeded := TierUsageExceeded(GenerateTier(target), storageUsageBytes, repositories); len(exceeded) > 0 {
+		return errors.Errorf(errTierLimitsExceeded, slug, strings.Join(exceeded, ", "))
+	}
+	return nil
+}
+
+// GenerateDockerCredentialsRequest generates *godo.RegistryDockerCredentialsRequest instance from DockerCredentialsParameters.
+func GenerateDockerCredentialsRequest(in *v1alpha1.DockerCredentialsParameters) *godo.RegistryDockerCredentialsRequest {
+	if in == nil {
+		return &godo.RegistryDockerCredentialsRequest{}
+	}
+	return &godo.RegistryDockerCredentialsRequest{
+		ReadWrite:     in.ReadWrite,
+		ExpirySeconds: in.ExpirySeconds,
+	}
+}
+
+// GenerateDockerCredentialsObservation generates DockerCredentialsObservation instance
+// for credentials issued with the supplied DockerCredentialsParameters at the supplied time.
+func GenerateDockerCredentialsObservation(in *v1alpha1.DockerCredentialsParameters, issuedAt time.Time) *v1alpha1.DockerCredentialsObservation {
+	issued := metav1.NewTime(issuedAt)
+	o := &v1alpha1.DockerCredentialsObservation{IssuedAt: &issued}
+	if in == nil {
+		return o
+	}
+	o.ReadWrite = in.ReadWrite
+	if in.ExpirySeconds != nil {
+		expiry := *in.ExpirySeconds
+		expires := metav1.NewTime(issuedAt.Add(time.Duration(expiry) * time.Second))
+		o.ExpirySeconds = &expiry
+		o.ExpiresAt = &expires
+	}
+	return o
+}
+
+// DockerCredentialsDue returns true if new Docker credentials should be issued,
+// either because none were issued yet, because the requested credentials differ
+// from the issued ones, or because three quarters of their lifetime have elapsed.
+func DockerCredentialsDue(p *v1alpha1.DockerCredentialsParameters, o *v1alpha1.DockerCredentialsObservation, now time.Time) bool {
+	if o == nil || o.IssuedAt == nil {
+		return true
+	}
+	if p == nil {
+		p = &v1alpha1.DockerCredentialsParameters{}
+	}
+	if p.ReadWrite != o.ReadWrite || do.IntValue(p.ExpirySeconds) != do.IntValue(o.ExpirySeconds) {
+		return true
+	}
+	if o.ExpiresAt == nil {
+		return false
+	}
+	lifetime := o.ExpiresAt.Sub(o.IssuedAt.Time)
+	return !now.Before(o.IssuedAt.Add(lifetime * 3 / 4))
+}
+
+// GenerateStartGarbageCollectionRequest generates *godo.StartGarbageCollectionRequest instance from GarbageCollectionPolicy.
+func GenerateStartGarbageCollectionRequest(in v1alpha1.GarbageCollectionPolicy) *godo.StartGarbageCollectionRequest {
+	if in.IncludeUntaggedManifests {
+		return &godo.StartGarbageCollectionRequest{Type: godo.GCTypeUntaggedManifestsAndUnreferencedBlobs}
+	}
+	return &godo.StartGarbageCollectionRequest{Type: godo.GCTypeUnreferencedBlobsOnly}
+}
+
+// GenerateGarbageCollectionRun generates GarbageCollectionRun instance from godo.GarbageCollection.
+func GenerateGarbageCollectionRun(gc *godo.GarbageCollection) *v1alpha1.GarbageCollectionRun {
+	if gc == nil {
+		return nil
+	}
+	return &v1alpha1.GarbageCollectionRun{
+		UUID:         gc.UUID,
+		Status:       gc.Status,
+		Type:         string(gc.Type),
+		CreatedAt:    gc.CreatedAt.String(),
+		UpdatedAt:    gc.UpdatedAt.String(),
+		BlobsDeleted: gc.BlobsDeleted,
+		FreedBytes:   gc.FreedBytes,
+	}
+}
+
+// ScheduleGarbageCollection records the next scheduled garbage collection of
+// the supplied policy in the supplied GarbageCollectionObservation. A schedule
+// that is observed for the first time starts counting from the supplied time.
+func ScheduleGarbageCollection(p v1alpha1.GarbageCollectionPolicy, o *v1alpha1.GarbageCollectionObservation, now time.Time) error {
+	schedule, err := cron.ParseStandard(p.Schedule)
+	if err != nil {
+		return err
+	}
+	if o.LastScheduledAt == nil {
+		last := metav1.NewTime(now)
+		o.LastScheduledAt = &last
+	}
+	next := metav1.NewTime(schedule.Next(o.LastScheduledAt.Time))
+	o.NextScheduledAt = &next
+	return nil
+}
+
+// GarbageCollectionActive returns true if the supplied garbage collection has
+// not finished yet.
+func GarbageCollectionActive(run *v1alpha1.GarbageCollectionRun) bool {
+	if run == nil {
+		return false
+	}
+	switch run.Status {
+	case GarbageCollectionStatusSucceeded, GarbageCollectionStatusFailed, GarbageCollectionStatusCancelled:
+		return false
+	default:
+		return true
+	}
+}
+
+// GarbageCollectionDue returns true if the next scheduled garbage collection
+// should be started.
+func GarbageCollectionDue(o *v1alpha1.GarbageCollectionObservation, now time.Time) bool {
+	if o == nil || o.NextScheduledAt == nil {
+		return false
+	}
+	return !now.Before(o.NextScheduledAt.Time) && !GarbageCollectionActive(o.Latest)
+}
+
+// RepositoryCountDue returns true if the repositories of a registry that were
+// last counted at the supplied time should be counted again.
+func RepositoryCountDue(countedAt *metav1.Time, now time.Time) bool {
+	return countedAt == nil || !now.Before(countedAt.Add(RepositoryCountIntervalMinutes*time.Minute))
+}
+
+// TagRetentionDue returns true if the repositories of the registry should be
+// evaluated against the supplied tag retention policy.
+func TagRetentionDue(p v1alpha1.TagRetentionPolicy, o *v1alpha1.TagRetentionObservation, now time.Time) bool {
+	if o == nil || o.LastEvaluatedAt == nil {
+		return true
+	}
+	interval := DefaultTagRetentionIntervalMinutes
+	if p.IntervalMinutes != nil {
+		interval = *p.IntervalMinutes
+	}
+	return !now.Before(o.LastEvaluatedAt.Add(time.Duration(interval) * time.Minute))
+}
+
+// ObserveExpiredTags records the number of supplied expired tags, in the form
+// repository:tag, and a sample of the last MaxExpiredTagSample of them.
+func ObserveExpiredTags(o *v1alpha1.TagRetentionObservation, expired []string) {
+	o.ExpiredTagCount = len(expired)
+	o.ExpiredTags = nil
+	if len(expired) == 0 {
+		return
+	}
+	sample := expired
+	if len(sample) > MaxExpiredTagSample {
+		sample = sample[len(sample)-MaxExpiredTagSample:]
+	}
+	o.ExpiredTags = make([]string, len(sample))
+	copy(o.ExpiredTags, sample)
+}
+
+// ExpiredTags returns the supplied tags of a single repository that are not
+// retained by the supplied tag retention policy, most recently updated first.
+func ExpiredTags(p v1alpha1.TagRetentionPolicy, tags []*godo.RepositoryTag, now time.Time) ([]*godo.RepositoryTag, error) {
+	if p.KeepLast == nil && p.MaxAgeDays == nil {
+		return nil, nil
+	}
+
+	protected := make([]*regexp.Regexp, len(p.ProtectedTags))
+	for i, expr := range p.ProtectedTags {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		protected[i] = re
+	}
+
+	candidates := make([]*godo.RepositoryTag, 0, len(tags))
+	for _, t := range tags {
+		if !matchesAny(protected, t.Tag) {
+			candidates = append(candidates, t)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].UpdatedAt.After(candidates[j].UpdatedAt)
+	})
+
+	if p.KeepLast != nil {
+		if *p.KeepLast >= len(candidates) {
+			return nil, nil
+		}
+		candidates = candidates[*p.KeepLast:]
+	}
+	if p.MaxAgeDays == nil {
+		return candidates, nil
+	}
+
+	cutoff := now.AddDate(0, 0, -*p.MaxAgeDays)
+	var expired []*godo.RepositoryTag
+	for _, t := range candidates {
+		if t.UpdatedAt.Before(cutoff) {
+			expired = append(expired, t)
+		}
+	}
+	return expired, nil
+}
+
+// PlanTagDeletions splits the supplied expired tags of a single repository
+// into the manifests that can be deleted as a whole, because all of their tags
+// expired, and the remaining tags that have to be deleted individually. All
+// tags of the repository are required to tell whether a manifest is still
+// referenced by a retained tag.
+func PlanTagDeletions(all, expired []*godo.RepositoryTag) (manifests []string, tags []string) {
+	retained := map[string]bool{}
+	isExpired := map[*godo.RepositoryTag]bool{}
+	for _, t := range expired {
+		isExpired[t] = true
+	}
+	for _, t := range all {
+		if !isExpired[t] {
+			retained[t.ManifestDigest] = true
+		}
+	}
+
+	planned := map[string]bool{}
+	for _, t := range expired {
+		switch {
+		case t.ManifestDigest == "" || retained[t.ManifestDigest]:
+			tags = append(tags, t.Tag)
+		case !planned[t.ManifestDigest]:
+			planned[t.ManifestDigest] = true
+			manifests = append(manifests, t.ManifestDigest)
+		}
+	}
+	return manifests, tags
+}
+
+func matchesAny(expressions []*regexp.Regexp, s string) bool {
+	for _, re := range expressions {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,11 +1,13 @@
 package kubernetes
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane-contrib/provider-digitalocean/apis/kubernetes/v1alpha1"
 )
@@ -90,3 +92,430 @@ func TestGenerateContainerRegistryObservation(t *testing.T) {
 		})
 	}
 }
+
+func TestDockerCredentialsDue(t *testing.T) {
+	now := time.Now()
+	issuedAt := metav1.NewTime(now.Add(-time.Hour))
+	expiresSoon := metav1.NewTime(now.Add(10 * time.Minute))
+	expiresLater := metav1.NewTime(now.Add(10 * time.Hour))
+	expiry := 3600
+
+	type args struct {
+		params      *v1alpha1.DockerCredentialsParameters
+		observation *v1alpha1.DockerCredentialsObservation
+	}
+	tests := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotIssued": {
+			args: args{},
+			want: true,
+		},
+		"NeverExpire": {
+			args: args{
+				observation: &v1alpha1.DockerCredentialsObservation{IssuedAt: &issuedAt},
+			},
+			want: false,
+		},
+		"ReadWriteChanged": {
+			args: args{
+				params:      &v1alpha1.DockerCredentialsParameters{ReadWrite: true},
+				observation: &v1alpha1.DockerCredentialsObservation{IssuedAt: &issuedAt},
+			},
+			want: true,
+		},
+		"ExpiringSoon": {
+			args: args{
+				params:      &v1alpha1.DockerCredentialsParameters{ExpirySeconds: &expiry},
+				observation: &v1alpha1.DockerCredentialsObservation{IssuedAt: &issuedAt, ExpirySeconds: &expiry, ExpiresAt: &expiresSoon},
+			},
+			want: true,
+		},
+		"NotExpiringSoon": {
+			args: args{
+				params:      &v1alpha1.DockerCredentialsParameters{ExpirySeconds: &expiry},
+				observation: &v1alpha1.DockerCredentialsObservation{IssuedAt: &issuedAt, ExpirySeconds: &expiry, ExpiresAt: &expiresLater},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := DockerCredentialsDue(tc.args.params, tc.args.observation, now)
+			assert.Equal(t, tc.want, r)
+		})
+	}
+}
+
+func TestScheduleGarbageCollection(t *testing.T) {
+	now := time.Date(2021, time.October, 20, 12, 0, 0, 0, time.UTC)
+	last := metav1.NewTime(time.Date(2021, time.October, 17, 3, 0, 0, 0, time.UTC))
+
+	type want struct {
+		last *metav1.Time
+		next *metav1.Time
+		err  bool
+	}
+	tests := map[string]struct {
+		schedule    string
+		observation *v1alpha1.GarbageCollectionObservation
+		want        want
+	}{
+		"FirstObservation": {
+			schedule:    "0 3 * * 0",
+			observation: &v1alpha1.GarbageCollectionObservation{},
+			want: want{
+				last: &metav1.Time{Time: now},
+				next: &metav1.Time{Time: time.Date(2021, time.October, 24, 3, 0, 0, 0, time.UTC)},
+			},
+		},
+		"Scheduled": {
+			schedule:    "0 3 * * *",
+			observation: &v1alpha1.GarbageCollectionObservation{LastScheduledAt: &last},
+			want: want{
+				last: &last,
+				next: &metav1.Time{Time: time.Date(2021, time.October, 18, 3, 0, 0, 0, time.UTC)},
+			},
+		},
+		"InvalidSchedule": {
+			schedule:    "every sunday",
+			observation: &v1alpha1.GarbageCollectionObservation{},
+			want: want{
+				err: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ScheduleGarbageCollection(v1alpha1.GarbageCollectionPolicy{Schedule: tc.schedule}, tc.observation, now)
+			assert.Equal(t, tc.want.err, err != nil)
+			assert.Equal(t, tc.want.last, tc.observation.LastScheduledAt)
+			assert.Equal(t, tc.want.next, tc.observation.NextScheduledAt)
+		})
+	}
+}
+
+func TestGarbageCollectionDue(t *testing.T) {
+	now := time.Now()
+	past := metav1.NewTime(now.Add(-time.Minute))
+	future := metav1.NewTime(now.Add(time.Minute))
+
+	tests := map[string]struct {
+		observation *v1alpha1.GarbageCollectionObservation
+		want        bool
+	}{
+		"NotScheduled": {
+			observation: nil,
+			want:        false,
+		},
+		"NotYetDue": {
+			observation: &v1alpha1.GarbageCollectionObservation{NextScheduledAt: &future},
+			want:        false,
+		},
+		"Due": {
+			observation: &v1alpha1.GarbageCollectionObservation{
+				NextScheduledAt: &past,
+				Latest:          &v1alpha1.GarbageCollectionRun{Status: GarbageCollectionStatusSucceeded},
+			},
+			want: true,
+		},
+		"StillActive": {
+			observation: &v1alpha1.GarbageCollectionObservation{
+				NextScheduledAt: &past,
+				Latest:          &v1alpha1.GarbageCollectionRun{Status: "scanning manifests"},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GarbageCollectionDue(tc.observation, now))
+		})
+	}
+}
+
+func TestObserveExpiredTags(t *testing.T) {
+	many := make([]string, MaxExpiredTagSample+5)
+	for i := range many {
+		many[i] = fmt.Sprintf("app:%d", i)
+	}
+
+	tests := map[string]struct {
+		observation v1alpha1.TagRetentionObservation
+		expired     []string
+		want        v1alpha1.TagRetentionObservation
+	}{
+		"None": {
+			observation: v1alpha1.TagRetentionObservation{ExpiredTagCount: 2, ExpiredTags: []string{"app:a", "app:b"}},
+			want:        v1alpha1.TagRetentionObservation{},
+		},
+		"Few": {
+			expired: []string{"app:a", "app:b"},
+			want:    v1alpha1.TagRetentionObservation{ExpiredTagCount: 2, ExpiredTags: []string{"app:a", "app:b"}},
+		},
+		"Many": {
+			expired: many,
+			want:    v1alpha1.TagRetentionObservation{ExpiredTagCount: len(many), ExpiredTags: many[5:]},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ObserveExpiredTags(&tc.observation, tc.expired)
+			assert.Equal(t, tc.want, tc.observation)
+		})
+	}
+}
+
+func TestExpiredTags(t *testing.T) {
+	now := time.Date(2021, time.October, 20, 12, 0, 0, 0, time.UTC)
+	keepTwo := 2
+	thirtyDays := 30
+
+	latest := &godo.RepositoryTag{Tag: "latest", ManifestDigest: "sha256:a", UpdatedAt: now.AddDate(0, 0, -100)}
+	newest := &godo.RepositoryTag{Tag: "c", ManifestDigest: "sha256:c", UpdatedAt: now.AddDate(0, 0, -1)}
+	recent := &godo.RepositoryTag{Tag: "b", ManifestDigest: "sha256:b", UpdatedAt: now.AddDate(0, 0, -10)}
+	old := &godo.RepositoryTag{Tag: "a", ManifestDigest: "sha256:a", UpdatedAt: now.AddDate(0, 0, -40)}
+	tags := []*godo.RepositoryTag{old, latest, newest, recent}
+
+	type want struct {
+		expired []*godo.RepositoryTag
+		err     bool
+	}
+	tests := map[string]struct {
+		policy v1alpha1.TagRetentionPolicy
+		want   want
+	}{
+		"NoRules": {
+			policy: v1alpha1.TagRetentionPolicy{},
+			want:   want{},
+		},
+		"KeepLast": {
+			policy: v1alpha1.TagRetentionPolicy{KeepLast: &keepTwo},
+			want:   want{expired: []*godo.RepositoryTag{old, latest}},
+		},
+		"KeepLastWithProtectedTags": {
+			policy: v1alpha1.TagRetentionPolicy{KeepLast: &keepTwo, ProtectedTags: []string{"^latest$"}},
+			want:   want{expired: []*godo.RepositoryTag{old}},
+		},
+		"MaxAge": {
+			policy: v1alpha1.TagRetentionPolicy{MaxAgeDays: &thirtyDays, ProtectedTags: []string{"^latest$"}},
+			want:   want{expired: []*godo.RepositoryTag{old}},
+		},
+		"MaxAgeKeepsLast": {
+			policy: v1alpha1.TagRetentionPolicy{MaxAgeDays: &thirtyDays, KeepLast: &keepTwo},
+			want:   want{expired: []*godo.RepositoryTag{old, latest}},
+		},
+		"InvalidProtectedTags": {
+			policy: v1alpha1.TagRetentionPolicy{KeepLast: &keepTwo, ProtectedTags: []string{"("}},
+			want:   want{err: true},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			expired, err := ExpiredTags(tc.policy, tags, now)
+			assert.Equal(t, tc.want.err, err != nil)
+			assert.Equal(t, tc.want.expired, expired)
+		})
+	}
+}
+
+func TestPlanTagDeletions(t *testing.T) {
+	a1 := &godo.RepositoryTag{Tag: "a1", ManifestDigest: "sha256:a"}
+	a2 := &godo.RepositoryTag{Tag: "a2", ManifestDigest: "sha256:a"}
+	b := &godo.RepositoryTag{Tag: "b", ManifestDigest: "sha256:b"}
+	c := &godo.RepositoryTag{Tag: "c", ManifestDigest: "sha256:c"}
+	all := []*godo.RepositoryTag{a1, a2, b, c}
+
+	type want struct {
+		manifests []string
+		tags      []string
+	}
+	tests := map[string]struct {
+		expired []*godo.RepositoryTag
+		want    want
+	}{
+		"Nothing": {
+			expired: nil,
+			want:    want{},
+		},
+		"WholeManifests": {
+			expired: []*godo.RepositoryTag{a1, a2, b},
+			want:    want{manifests: []string{"sha256:a", "sha256:b"}},
+		},
+		"ManifestStillTagged": {
+			expired: []*godo.RepositoryTag{a1, c},
+			want:    want{manifests: []string{"sha256:c"}, tags: []string{"a1"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			manifests, tags := PlanTagDeletions(all, tc.expired)
+			assert.Equal(t, tc.want.manifests, manifests)
+			assert.Equal(t, tc.want.tags, tags)
+		})
+	}
+}
+
+func TestTagRetentionDue(t *testing.T) {
+	now := time.Now()
+	interval := 10
+	recently := metav1.NewTime(now.Add(-5 * time.Minute))
+	longAgo := metav1.NewTime(now.Add(-2 * time.Hour))
+
+	tests := map[string]struct {
+		policy      v1alpha1.TagRetentionPolicy
+		observation *v1alpha1.TagRetentionObservation
+		want        bool
+	}{
+		"NeverEvaluated": {
+			observation: nil,
+			want:        true,
+		},
+		"RecentlyEvaluated": {
+			observation: &v1alpha1.TagRetentionObservation{LastEvaluatedAt: &recently},
+			want:        false,
+		},
+		"DefaultIntervalElapsed": {
+			observation: &v1alpha1.TagRetentionObservation{LastEvaluatedAt: &longAgo},
+			want:        true,
+		},
+		"IntervalNotElapsed": {
+			policy:      v1alpha1.TagRetentionPolicy{IntervalMinutes: &interval},
+			observation: &v1alpha1.TagRetentionObservation{LastEvaluatedAt: &recently},
+			want:        false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, TagRetentionDue(tc.policy, tc.observation, now))
+		})
+	}
+}
+
+func TestValidateTierChange(t *testing.T) {
+	options := &godo.RegistryOptions{
+		SubscriptionTiers: []*godo.RegistrySubscriptionTier{
+			{Slug: "starter", IncludedRepositories: 1, IncludedStorageBytes: 500},
+			{Slug: "basic", IncludedRepositories: 5, IncludedStorageBytes: 1000, AllowStorageOverage: true},
+			{Slug: "professional", IncludedRepositories: 0, IncludedStorageBytes: 2000, AllowStorageOverage: true},
+		},
+	}
+
+	tests := map[string]struct {
+		slug         string
+		storage      uint64
+		repositories uint64
+		want         bool
+	}{
+		"WithinLimits": {
+			slug:         "starter",
+			storage:      100,
+			repositories: 1,
+			want:         false,
+		},
+		"TooManyRepositories": {
+			slug:         "starter",
+			storage:      100,
+			repositories: 2,
+			want:         true,
+		},
+		"TooMuchStorage": {
+			slug:         "starter",
+			storage:      600,
+			repositories: 1,
+			want:         true,
+		},
+		"StorageOverageAllowed": {
+			slug:         "basic",
+			storage:      5000,
+			repositories: 5,
+			want:         false,
+		},
+		"UnlimitedRepositories": {
+			slug:         "professional",
+			repositories: 100,
+			want:         false,
+		},
+		"UnknownTier": {
+			slug: "enterprise",
+			want: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateTierChange(tc.slug, options, tc.storage, tc.repositories)
+			assert.Equal(t, tc.want, err != nil)
+		})
+	}
+}
+
+func TestTierUsageExceeded(t *testing.T) {
+	starter := v1alpha1.Tier{Slug: "starter", IncludedRepositories: 1, IncludedStorageBytes: 500}
+	basic := v1alpha1.Tier{Slug: "basic", IncludedRepositories: 5, IncludedStorageBytes: 1000, AllowStorageOverage: true}
+
+	tests := map[string]struct {
+		tier         v1alpha1.Tier
+		storage      uint64
+		repositories uint64
+		want         int
+	}{
+		"WithinTier": {
+			tier:         starter,
+			storage:      100,
+			repositories: 1,
+			want:         0,
+		},
+		"StorageExceeded": {
+			tier:         starter,
+			storage:      600,
+			repositories: 1,
+			want:         1,
+		},
+		"StorageOverageAllowed": {
+			tier:         basic,
+			storage:      5000,
+			repositories: 5,
+			want:         0,
+		},
+		"RepositoriesExceededDespiteOverage": {
+			tier:         basic,
+			storage:      5000,
+			repositories: 6,
+			want:         1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Len(t, TierUsageExceeded(tc.tier, tc.storage, tc.repositories), tc.want)
+		})
+	}
+}
+
+func TestRepositoryCountDue(t *testing.T) {
+	now := time.Now()
+	recently := metav1.NewTime(now.Add(-5 * time.Minute))
+	longAgo := metav1.NewTime(now.Add(-2 * time.Hour))
+
+	tests := map[string]struct {
+		countedAt *metav1.Time
+		want      bool
+	}{
+		"NeverCounted": {
+			want: true,
+		},
+		"RecentlyCounted": {
+			countedAt: &recently,
+			want:      false,
+		},
+		"IntervalElapsed": {
+			countedAt: &longAgo,
+			want:      true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, RepositoryCountDue(tc.countedAt, now))
+		})
+	}
+}
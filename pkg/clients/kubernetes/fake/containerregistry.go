@@ -14,10 +14,21 @@ var _ kubernetes.RegistryClient = (*MockRegistryClient)(nil)
 // MockRegistryClient is a type that implements all the methods for RegistryClient interface
 type MockRegistryClient struct {
 	MockGet                func(context.Context) (*godo.Registry, *godo.Response, error)
+	MockGetOptions         func(context.Context) (*godo.RegistryOptions, *godo.Response, error)
 	MockGetSubscription    func(context.Context) (*godo.RegistrySubscription, *godo.Response, error)
 	MockCreate             func(context.Context, *godo.RegistryCreateRequest) (*godo.Registry, *godo.Response, error)
 	MockUpdateSubscription func(context.Context, *godo.RegistrySubscriptionUpdateRequest) (*godo.RegistrySubscription, *godo.Response, error)
 	MockDelete             func(context.Context) (*godo.Response, error)
+	MockDockerCredentials  func(context.Context, *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, *godo.Response, error)
+
+	MockStartGarbageCollection func(context.Context, string, ...*godo.StartGarbageCollectionRequest) (*godo.GarbageCollection, *godo.Response, error)
+	MockGetGarbageCollection   func(context.Context, string) (*godo.GarbageCollection, *godo.Response, error)
+	MockListGarbageCollections func(context.Context, string, *godo.ListOptions) ([]*godo.GarbageCollection, *godo.Response, error)
+
+	MockListRepositoriesV2 func(context.Context, string, *godo.TokenListOptions) ([]*godo.RepositoryV2, *godo.Response, error)
+	MockListRepositoryTags func(context.Context, string, string, *godo.ListOptions) ([]*godo.RepositoryTag, *godo.Response, error)
+	MockDeleteTag          func(context.Context, string, string, string) (*godo.Response, error)
+	MockDeleteManifest     func(context.Context, string, string, string) (*godo.Response, error)
 }
 
 // Get mocks Get method
@@ -25,6 +36,11 @@ func (c *MockRegistryClient) Get(ctx context.Context) (*godo.Registry, *godo.Res
 	return c.MockGet(ctx)
 }
 
+// GetOptions mocks GetOptions method
+func (c *MockRegistryClient) GetOptions(ctx context.Context) (*godo.RegistryOptions, *godo.Response, error) {
+	return c.MockGetOptions(ctx)
+}
+
 // GetSubscription mocks GetSubscription method
 func (c *MockRegistryClient) GetSubscription(ctx context.Context) (*godo.RegistrySubscription, *godo.Response, error) {
 	return c.MockGetSubscription(ctx)
@@ -44,3 +60,43 @@ func (c *MockRegistryClient) UpdateSubscription(ctx context.Context, request *go
 func (c *MockRegistryClient) Delete(ctx context.Context) (*godo.Response, error) {
 	return c.MockDelete(ctx)
 }
+
+// DockerCredentials mocks DockerCredentials method
+func (c *MockRegistryClient) DockerCredentials(ctx context.Context, request *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, *godo.Response, error) {
+	return c.MockDockerCredentials(ctx, request)
+}
+
+// StartGarbageCollection mocks StartGarbageCollection method
+func (c *MockRegistryClient) StartGarbageCollection(ctx context.Context, registry string, request ...*godo.StartGarbageCollectionRequest) (*godo.GarbageCollection, *godo.Response, error) {
+	return c.MockStartGarbageCollection(ctx, registry, request...)
+}
+
+// GetGarbageCollection mocks GetGarbageCollection method
+func (c *MockRegistryClient) GetGarbageCollection(ctx context.Context, registry string) (*godo.GarbageCollection, *godo.Response, error) {
+	return c.MockGetGarbageCollection(ctx, registry)
+}
+
+// ListGarbageCollections mocks ListGarbageCollections method
+func (c *MockRegistryClient) ListGarbageCollections(ctx context.Context, registry string, opts *godo.ListOptions) ([]*godo.GarbageCollection, *godo.Response, error) {
+	return c.MockListGarbageCollections(ctx, registry, opts)
+}
+
+// ListRepositoriesV2 mocks ListRepositoriesV2 method
+func (c *MockRegistryClient) ListRepositoriesV2(ctx context.Context, registry string, opts *godo.TokenListOptions) ([]*godo.RepositoryV2, *godo.Response, error) {
+	return c.MockListRepositoriesV2(ctx, registry, opts)
+}
+
+// ListRepositoryTags mocks ListRepositoryTags method
+func (c *MockRegistryClient) ListRepositoryTags(ctx context.Context, registry, repository string, opts *godo.ListOptions) ([]*godo.RepositoryTag, *godo.Response, error) {
+	return c.MockListRepositoryTags(ctx, registry, repository, opts)
+}
+
+// DeleteTag mocks DeleteTag method
+func (c *MockRegistryClient) DeleteTag(ctx context.Context, registry, repository, tag string) (*godo.Response, error) {
+	return c.MockDeleteTag(ctx, registry, repository, tag)
+}
+
+// DeleteManifest mocks DeleteManifest method
+func (c *MockRegistryClient) DeleteManifest(ctx context.Context, registry, repository, digest string) (*godo.Response, error) {
+	return c.MockDeleteManifest(ctx, registry, repository, digest)
+}
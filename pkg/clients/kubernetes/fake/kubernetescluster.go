@@ -0,0 +1,48 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+)
+
+// this ensures that the mock implements the client interface
+var _ godo.KubernetesService = (*MockKubernetesService)(nil)
+
+// MockKubernetesService is a type that implements the methods of
+// godo.KubernetesService used by the DOKubernetesCluster controller. Calling
+// any other method panics.
+type MockKubernetesService struct {
+	godo.KubernetesService
+
+	MockGet        func(context.Context, string) (*godo.KubernetesCluster, *godo.Response, error)
+	MockGetOptions func(context.Context) (*godo.KubernetesOptions, *godo.Response, error)
+	MockCreate     func(context.Context, *godo.KubernetesClusterCreateRequest) (*godo.KubernetesCluster, *godo.Response, error)
+	MockDelete     func(context.Context, string) (*godo.Response, error)
+	MockDeleteNode func(context.Context, string, string, string, *godo.KubernetesNodeDeleteRequest) (*godo.Response, error)
+}
+
+// Get mocks Get method
+func (c *MockKubernetesService) Get(ctx context.Context, clusterID string) (*godo.KubernetesCluster, *godo.Response, error) {
+	return c.MockGet(ctx, clusterID)
+}
+
+// GetOptions mocks GetOptions method
+func (c *MockKubernetesService) GetOptions(ctx context.Context) (*godo.KubernetesOptions, *godo.Response, error) {
+	return c.MockGetOptions(ctx)
+}
+
+// Create mocks Create method
+func (c *MockKubernetesService) Create(ctx context.Context, create *godo.KubernetesClusterCreateRequest) (*godo.KubernetesCluster, *godo.Response, error) {
+	return c.MockCreate(ctx, create)
+}
+
+// Delete mocks Delete method
+func (c *MockKubernetesService) Delete(ctx context.Context, clusterID string) (*godo.Response, error) {
+	return c.MockDelete(ctx, clusterID)
+}
+
+// DeleteNode mocks DeleteNode method
+func (c *MockKubernetesService) DeleteNode(ctx context.Context, clusterID, poolID, nodeID string, req *godo.KubernetesNodeDeleteRequest) (*godo.Response, error) {
+	return c.MockDeleteNode(ctx, clusterID, poolID, nodeID, req)
+}
@@ -14,7 +14,13 @@ limitations under the License.
 package kubernetes
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
@@ -22,6 +28,22 @@ import (
 	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
 )
 
+const (
+	// DefaultClusterlintIntervalMinutes is the number of minutes between two
+	// clusterlint runs when no interval is configured.
+	DefaultClusterlintIntervalMinutes = 1440
+
+	clusterlintSeverityError = "error"
+
+	// VersionLatest is the version alias that resolves to the latest published
+	// Kubernetes version.
+	VersionLatest = "latest"
+
+	errVersionNotAvailable  = "version %q is not available, available versions are: %s"
+	errRegionNotAvailable   = "region %q is not available, available regions are: %s"
+	errNodeSizeNotAvailable = "size %q of node pool %q is not available"
+)
+
 // GenerateKubernetes generates *godo.KubernetesRequest instance from DOKubernetesClusterParameters.
 func GenerateKubernetes(name string, in v1alpha1.DOKubernetesClusterParameters, create *godo.KubernetesClusterCreateRequest) {
 	create.Name = name
@@ -211,3 +233,278 @@ func LateInitializeSpec(p *v1alpha1.DOKubernetesClusterParameters, observed godo
 	p.SurgeUpgrade = do.LateInitializeBool(p.SurgeUpgrade, observed.SurgeUpgrade)
 	p.HighlyAvailable = do.LateInitializeBool(p.HighlyAvailable, observed.HA)
 }
+
+// GenerateClusterlintRequest generates *godo.KubernetesRunClusterlintRequest instance from KubernetesClusterlintPolicy.
+func GenerateClusterlintRequest(in v1alpha1.KubernetesClusterlintPolicy) *godo.KubernetesRunClusterlintRequest {
+	return &godo.KubernetesRunClusterlintRequest{
+		IncludeGroups: in.IncludeGroups,
+		ExcludeGroups: in.ExcludeGroups,
+		IncludeChecks: in.IncludeChecks,
+		ExcludeChecks: in.ExcludeChecks,
+	}
+}
+
+// GenerateClusterlintDiagnostics summarises the diagnostics returned by godo into KubernetesClusterlintDiagnostics.
+func GenerateClusterlintDiagnostics(in []*godo.ClusterlintDiagnostic) []v1alpha1.KubernetesClusterlintDiagnostic {
+	diagnostics := make([]v1alpha1.KubernetesClusterlintDiagnostic, 0, len(in))
+	for _, d := range in {
+		if d == nil {
+			continue
+		}
+		diagnostic := v1alpha1.KubernetesClusterlintDiagnostic{
+			CheckName: d.CheckName,
+			Severity:  d.Severity,
+			Message:   d.Message,
+		}
+		if d.Object != nil {
+			diagnostic.ObjectKind = d.Object.Kind
+			diagnostic.ObjectName = d.Object.Name
+			diagnostic.ObjectNamespace = d.Object.Namespace
+		}
+		diagnostics = append(diagnostics, diagnostic)
+	}
+	return diagnostics
+}
+
+// CountClusterlintErrors returns the number of diagnostics with an error severity.
+func CountClusterlintErrors(diagnostics []v1alpha1.KubernetesClusterlintDiagnostic) int {
+	count := 0
+	for _, d := range diagnostics {
+		if d.Severity == clusterlintSeverityError {
+			count++
+		}
+	}
+	return count
+}
+
+// LatestUpgradeVersion returns the slug of the most recent version a cluster
+// can be upgraded to, or an empty string if no upgrade is available.
+func LatestUpgradeVersion(upgrades []*godo.KubernetesVersion) string {
+	if len(upgrades) == 0 || upgrades[len(upgrades)-1] == nil {
+		return ""
+	}
+	return upgrades[len(upgrades)-1].Slug
+}
+
+// ClusterlintDue returns true if a new clusterlint run should be requested,
+// either because the configured interval has elapsed since the last run or
+// because a new upgrade has become available since then.
+func ClusterlintDue(p v1alpha1.KubernetesClusterlintPolicy, o *v1alpha1.KubernetesClusterlintObservation, upgradeVersion string, now time.Time) bool {
+	if !p.Enabled {
+		return false
+	}
+	if o == nil || o.RunID == "" || o.RequestedAt == nil {
+		return true
+	}
+	if upgradeVersion != "" && upgradeVersion != o.UpgradeVersion {
+		return true
+	}
+	interval := DefaultClusterlintIntervalMinutes
+	if p.IntervalMinutes != nil {
+		interval = *p.IntervalMinutes
+	}
+	return !now.Before(o.RequestedAt.Add(time.Duration(interval) * time.Minute))
+}
+
+// ObserveNodePoolRecycling carries the recycle state of the previously observed
+// node pools over to the current observation, starts a new recycle when the
+// recycle generation of a node pool was increased and marks the nodes that are
+// waiting to be replaced. Observations are expected to be in the same order as
+// the node pools of the observed cluster.
+func ObserveNodePoolRecycling(pools []v1alpha1.KubernetesNodePool, previous []v1alpha1.KubernetesNodePoolObservation, observed *godo.KubernetesCluster, current []v1alpha1.KubernetesNodePoolObservation, now time.Time) {
+	for i := range current {
+		obs := &current[i]
+		prev := findNodePoolObservation(previous, obs.Name)
+		if prev != nil {
+			obs.RecycleGeneration = prev.RecycleGeneration
+			obs.RecycleStartedAt = prev.RecycleStartedAt
+			if containsNode(obs.Nodes, prev.RecyclingNodeID) {
+				obs.RecyclingNodeID = prev.RecyclingNodeID
+			}
+		}
+
+		pool := findNodePool(pools, obs.Name)
+		if pool == nil {
+			continue
+		}
+
+		switch {
+		case prev == nil:
+			// A node pool that is observed for the first time adopts the
+			// requested generation without replacing its fresh nodes.
+			obs.RecycleGeneration = pool.RecycleGeneration
+		case pool.RecycleGeneration > obs.RecycleGeneration:
+			startedAt := metav1.NewTime(now)
+			obs.RecycleGeneration = pool.RecycleGeneration
+			obs.RecycleStartedAt = &startedAt
+		}
+
+		for j, node := range observed.NodePools[i].Nodes {
+			obs.Nodes[j].PendingRecycle = containsString(pool.RecycleNodeNames, node.Name) ||
+				(obs.RecycleStartedAt != nil && node.CreatedAt.Before(obs.RecycleStartedAt.Time))
+		}
+	}
+}
+
+// NodePoolsRecyclePending returns true if any node of the observed node pools
+// is waiting to be replaced.
+func NodePoolsRecyclePending(pools []v1alpha1.KubernetesNodePoolObservation) bool {
+	for _, pool := range pools {
+		for _, node := range pool.Nodes {
+			if node.PendingRecycle {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NextNodeToRecycle returns the ID of the next node of the observed node pool
+// that should be replaced. Nodes are replaced one at a time, so an empty ID is
+// returned while a previously deleted node is still listed, while any node of
+// the pool is not running or while the pool has fewer nodes than it should.
+// Auto-scaled pools should have at least their minimum number of nodes.
+func NextNodeToRecycle(pool v1alpha1.KubernetesNodePoolObservation) string {
+	if pool.RecyclingNodeID != "" {
+		return ""
+	}
+	minNodes := pool.Count
+	if pool.AutoScale {
+		minNodes = pool.MinNodes
+	}
+	if len(pool.Nodes) < minNodes {
+		return ""
+	}
+	next := ""
+	for _, node := range pool.Nodes {
+		if node.Status.State != v1alpha1.KubernetesStateRunning {
+			return ""
+		}
+		if next == "" && node.PendingRecycle {
+			next = node.ID
+		}
+	}
+	return next
+}
+
+func findNodePool(pools []v1alpha1.KubernetesNodePool, name string) *v1alpha1.KubernetesNodePool {
+	for i := range pools {
+		if pools[i].Name == name {
+			return &pools[i]
+		}
+	}
+	return nil
+}
+
+func findNodePoolObservation(pools []v1alpha1.KubernetesNodePoolObservation, name string) *v1alpha1.KubernetesNodePoolObservation {
+	for i := range pools {
+		if pools[i].Name == name {
+			return &pools[i]
+		}
+	}
+	return nil
+}
+
+func containsNode(nodes []v1alpha1.KubernetesNode, id string) bool {
+	if id == "" {
+		return false
+	}
+	for _, n := range nodes {
+		if n.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveVersion resolves the supplied version to a version slug published in
+// the supplied options. A version may be an exact slug (e.g. "1.21.5-do.0"),
+// a patch (e.g. "1.21.5") or minor (e.g. "1.21") version, in which case the
+// latest slug within it is used, or "latest" for the latest published slug.
+func ResolveVersion(version string, options *godo.KubernetesOptions) (string, error) {
+	resolved := ""
+	slugs := make([]string, 0, len(options.Versions))
+	for _, v := range options.Versions {
+		if v == nil {
+			continue
+		}
+		slugs = append(slugs, v.Slug)
+		if v.Slug == version {
+			return v.Slug, nil
+		}
+		if version != VersionLatest && v.KubernetesVersion != version && !strings.HasPrefix(v.KubernetesVersion, version+".") {
+			continue
+		}
+		if resolved == "" || compareVersionSlugs(v.Slug, resolved) > 0 {
+			resolved = v.Slug
+		}
+	}
+	if resolved == "" {
+		return "", errors.Errorf(errVersionNotAvailable, version, strings.Join(slugs, ", "))
+	}
+	return resolved, nil
+}
+
+// ValidateParameters validates the region, version and node pool sizes of the
+// supplied DOKubernetesClusterParameters against the supplied options and
+// returns the resolved version slug.
+func ValidateParameters(p v1alpha1.DOKubernetesClusterParameters, options *godo.KubernetesOptions) (string, error) {
+	regions := make([]string, 0, len(options.Regions))
+	for _, r := range options.Regions {
+		if r != nil {
+			regions = append(regions, r.Slug)
+		}
+	}
+	if !containsString(regions, p.Region) {
+		return "", errors.Errorf(errRegionNotAvailable, p.Region, strings.Join(regions, ", "))
+	}
+
+	sizes := make([]string, 0, len(options.Sizes))
+	for _, s := range options.Sizes {
+		if s != nil {
+			sizes = append(sizes, s.Slug)
+		}
+	}
+	for _, pool := range p.NodePools {
+		if !containsString(sizes, pool.Size) {
+			return "", errors.Errorf(errNodeSizeNotAvailable, pool.Size, pool.Name)
+		}
+	}
+
+	return ResolveVersion(p.Version, options)
+}
+
+// compareVersionSlugs compares two version slugs such as "1.21.5-do.0" and
+// returns a negative number, zero or a positive number if a is older than,
+// equal to or newer than b.
+func compareVersionSlugs(a, b string) int {
+	pa, pb := versionSlugParts(a), versionSlugParts(b)
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		if pa[i] != pb[i] {
+			return pa[i] - pb[i]
+		}
+	}
+	return len(pa) - len(pb)
+}
+
+func versionSlugParts(slug string) []int {
+	fields := strings.FieldsFunc(slug, func(r rune) bool {
+		return r == '.' || r == '-'
+	})
+	parts := make([]int, 0, len(fields))
+	for _, f := range fields {
+		if n, err := strconv.Atoi(f); err == nil {
+			parts = append(parts, n)
+		}
+	}
+	return parts
+}
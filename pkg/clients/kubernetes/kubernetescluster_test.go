@@ -0,0 +1,380 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/kubernetes/v1alpha1"
+)
+
+func TestClusterlintDue(t *testing.T) {
+	now := time.Now()
+	requestedAt := metav1.NewTime(now.Add(-time.Hour))
+	interval := 30
+
+	type args struct {
+		policy         v1alpha1.KubernetesClusterlintPolicy
+		observation    *v1alpha1.KubernetesClusterlintObservation
+		upgradeVersion string
+	}
+	tests := map[string]struct {
+		args args
+		want bool
+	}{
+		"Disabled": {
+			args: args{
+				policy: v1alpha1.KubernetesClusterlintPolicy{Enabled: false},
+			},
+			want: false,
+		},
+		"NeverRun": {
+			args: args{
+				policy: v1alpha1.KubernetesClusterlintPolicy{Enabled: true},
+			},
+			want: true,
+		},
+		"WithinDefaultInterval": {
+			args: args{
+				policy:      v1alpha1.KubernetesClusterlintPolicy{Enabled: true},
+				observation: &v1alpha1.KubernetesClusterlintObservation{RunID: "run", RequestedAt: &requestedAt},
+			},
+			want: false,
+		},
+		"IntervalElapsed": {
+			args: args{
+				policy:      v1alpha1.KubernetesClusterlintPolicy{Enabled: true, IntervalMinutes: &interval},
+				observation: &v1alpha1.KubernetesClusterlintObservation{RunID: "run", RequestedAt: &requestedAt},
+			},
+			want: true,
+		},
+		"NewUpgradeAvailable": {
+			args: args{
+				policy:         v1alpha1.KubernetesClusterlintPolicy{Enabled: true},
+				observation:    &v1alpha1.KubernetesClusterlintObservation{RunID: "run", RequestedAt: &requestedAt, UpgradeVersion: "1.21.5-do.0"},
+				upgradeVersion: "1.22.2-do.0",
+			},
+			want: true,
+		},
+		"SameUpgradeAvailable": {
+			args: args{
+				policy:         v1alpha1.KubernetesClusterlintPolicy{Enabled: true},
+				observation:    &v1alpha1.KubernetesClusterlintObservation{RunID: "run", RequestedAt: &requestedAt, UpgradeVersion: "1.22.2-do.0"},
+				upgradeVersion: "1.22.2-do.0",
+			},
+			want: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := ClusterlintDue(tc.args.policy, tc.args.observation, tc.args.upgradeVersion, now)
+			assert.Equal(t, tc.want, r)
+		})
+	}
+}
+
+func TestGenerateClusterlintDiagnostics(t *testing.T) {
+	tests := map[string]struct {
+		args       []*godo.ClusterlintDiagnostic
+		want       []v1alpha1.KubernetesClusterlintDiagnostic
+		wantErrors int
+	}{
+		"Empty": {
+			args: nil,
+			want: []v1alpha1.KubernetesClusterlintDiagnostic{},
+		},
+		"AllFilled": {
+			args: []*godo.ClusterlintDiagnostic{
+				{
+					CheckName: "unused-pv",
+					Severity:  "warning",
+					Message:   "Unused Persistent Volume 'pv'.",
+					Object:    &godo.ClusterlintObject{Kind: "persistent volume", Name: "pv"},
+				},
+				{
+					CheckName: "webhook-replacement",
+					Severity:  "error",
+					Message:   "Webhook matches objects in the kube-system namespace.",
+					Object:    &godo.ClusterlintObject{Kind: "validating webhook configuration", Name: "hook", Namespace: "default"},
+				},
+				nil,
+			},
+			want: []v1alpha1.KubernetesClusterlintDiagnostic{
+				{
+					CheckName:  "unused-pv",
+					Severity:   "warning",
+					Message:    "Unused Persistent Volume 'pv'.",
+					ObjectKind: "persistent volume",
+					ObjectName: "pv",
+				},
+				{
+					CheckName:       "webhook-replacement",
+					Severity:        "error",
+					Message:         "Webhook matches objects in the kube-system namespace.",
+					ObjectKind:      "validating webhook configuration",
+					ObjectName:      "hook",
+					ObjectNamespace: "default",
+				},
+			},
+			wantErrors: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateClusterlintDiagnostics(tc.args)
+			assert.Equal(t, tc.want, r)
+			assert.Equal(t, tc.wantErrors, CountClusterlintErrors(r))
+		})
+	}
+}
+
+func TestObserveNodePoolRecycling(t *testing.T) {
+	now := time.Now()
+	startedAt := metav1.NewTime(now.Add(-time.Hour))
+	observed := &godo.KubernetesCluster{
+		NodePools: []*godo.KubernetesNodePool{
+			{
+				Name: "pool",
+				Nodes: []*godo.KubernetesNode{
+					{ID: "old", Name: "pool-old", CreatedAt: now.Add(-2 * time.Hour)},
+					{ID: "new", Name: "pool-new", CreatedAt: now.Add(-time.Minute)},
+				},
+			},
+		},
+	}
+
+	type args struct {
+		pools    []v1alpha1.KubernetesNodePool
+		previous []v1alpha1.KubernetesNodePoolObservation
+	}
+	tests := map[string]struct {
+		args args
+		want v1alpha1.KubernetesNodePoolObservation
+	}{
+		"FirstObservation": {
+			args: args{
+				pools: []v1alpha1.KubernetesNodePool{{Name: "pool", RecycleGeneration: 1}},
+			},
+			want: v1alpha1.KubernetesNodePoolObservation{
+				Name:              "pool",
+				RecycleGeneration: 1,
+				Nodes:             []v1alpha1.KubernetesNode{{ID: "old"}, {ID: "new"}},
+			},
+		},
+		"RecycleNodeNames": {
+			args: args{
+				pools:    []v1alpha1.KubernetesNodePool{{Name: "pool", RecycleNodeNames: []string{"pool-new"}}},
+				previous: []v1alpha1.KubernetesNodePoolObservation{{Name: "pool"}},
+			},
+			want: v1alpha1.KubernetesNodePoolObservation{
+				Name:  "pool",
+				Nodes: []v1alpha1.KubernetesNode{{ID: "old"}, {ID: "new", PendingRecycle: true}},
+			},
+		},
+		"RecycleInProgress": {
+			args: args{
+				pools:    []v1alpha1.KubernetesNodePool{{Name: "pool", RecycleGeneration: 2}},
+				previous: []v1alpha1.KubernetesNodePoolObservation{{Name: "pool", RecycleGeneration: 2, RecycleStartedAt: &startedAt}},
+			},
+			want: v1alpha1.KubernetesNodePoolObservation{
+				Name:              "pool",
+				RecycleGeneration: 2,
+				RecycleStartedAt:  &startedAt,
+				Nodes:             []v1alpha1.KubernetesNode{{ID: "old", PendingRecycle: true}, {ID: "new"}},
+			},
+		},
+		"RecycleStarted": {
+			args: args{
+				pools:    []v1alpha1.KubernetesNodePool{{Name: "pool", RecycleGeneration: 3}},
+				previous: []v1alpha1.KubernetesNodePoolObservation{{Name: "pool", RecycleGeneration: 2, RecycleStartedAt: &startedAt}},
+			},
+			want: v1alpha1.KubernetesNodePoolObservation{
+				Name:              "pool",
+				RecycleGeneration: 3,
+				RecycleStartedAt:  &metav1.Time{Time: now},
+				Nodes:             []v1alpha1.KubernetesNode{{ID: "old", PendingRecycle: true}, {ID: "new", PendingRecycle: true}},
+			},
+		},
+		"RecyclingNodeListed": {
+			args: args{
+				pools:    []v1alpha1.KubernetesNodePool{{Name: "pool", RecycleNodeNames: []string{"pool-old"}}},
+				previous: []v1alpha1.KubernetesNodePoolObservation{{Name: "pool", RecyclingNodeID: "old"}},
+			},
+			want: v1alpha1.KubernetesNodePoolObservation{
+				Name:            "pool",
+				RecyclingNodeID: "old",
+				Nodes:           []v1alpha1.KubernetesNode{{ID: "old", PendingRecycle: true}, {ID: "new"}},
+			},
+		},
+		"RecyclingNodeGone": {
+			args: args{
+				pools:    []v1alpha1.KubernetesNodePool{{Name: "pool"}},
+				previous: []v1alpha1.KubernetesNodePoolObservation{{Name: "pool", RecyclingNodeID: "gone"}},
+			},
+			want: v1alpha1.KubernetesNodePoolObservation{
+				Name:  "pool",
+				Nodes: []v1alpha1.KubernetesNode{{ID: "old"}, {ID: "new"}},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			current := []v1alpha1.KubernetesNodePoolObservation{
+				{Name: "pool", Nodes: []v1alpha1.KubernetesNode{{ID: "old"}, {ID: "new"}}},
+			}
+			ObserveNodePoolRecycling(tc.args.pools, tc.args.previous, observed, current, now)
+			assert.Equal(t, tc.want, current[0])
+		})
+	}
+}
+
+func TestNextNodeToRecycle(t *testing.T) {
+	running := v1alpha1.KubernetesStatus{State: v1alpha1.KubernetesStateRunning}
+	provisioning := v1alpha1.KubernetesStatus{State: v1alpha1.KubernetesStateProvisioning}
+
+	tests := map[string]struct {
+		args v1alpha1.KubernetesNodePoolObservation
+		want string
+	}{
+		"NothingPending": {
+			args: v1alpha1.KubernetesNodePoolObservation{
+				Count: 2,
+				Nodes: []v1alpha1.KubernetesNode{{ID: "a", Status: running}, {ID: "b", Status: running}},
+			},
+			want: "",
+		},
+		"NextPending": {
+			args: v1alpha1.KubernetesNodePoolObservation{
+				Count: 2,
+				Nodes: []v1alpha1.KubernetesNode{{ID: "a", Status: running}, {ID: "b", Status: running, PendingRecycle: true}},
+			},
+			want: "b",
+		},
+		"ReplacementProvisioning": {
+			args: v1alpha1.KubernetesNodePoolObservation{
+				Count: 2,
+				Nodes: []v1alpha1.KubernetesNode{{ID: "a", Status: provisioning}, {ID: "b", Status: running, PendingRecycle: true}},
+			},
+			want: "",
+		},
+		"ReplacementMissing": {
+			args: v1alpha1.KubernetesNodePoolObservation{
+				Count: 2,
+				Nodes: []v1alpha1.KubernetesNode{{ID: "b", Status: running, PendingRecycle: true}},
+			},
+			want: "",
+		},
+		"DeletedNodeStillRunning": {
+			args: v1alpha1.KubernetesNodePoolObservation{
+				Count:           2,
+				RecyclingNodeID: "a",
+				Nodes:           []v1alpha1.KubernetesNode{{ID: "a", Status: running, PendingRecycle: true}, {ID: "b", Status: running, PendingRecycle: true}},
+			},
+			want: "",
+		},
+		"AutoScaledAboveMinNodes": {
+			args: v1alpha1.KubernetesNodePoolObservation{
+				Count:     3,
+				AutoScale: true,
+				MinNodes:  1,
+				MaxNodes:  5,
+				Nodes:     []v1alpha1.KubernetesNode{{ID: "a", Status: running}, {ID: "b", Status: running, PendingRecycle: true}},
+			},
+			want: "b",
+		},
+		"AutoScaledBelowMinNodes": {
+			args: v1alpha1.KubernetesNodePoolObservation{
+				Count:     3,
+				AutoScale: true,
+				MinNodes:  3,
+				MaxNodes:  5,
+				Nodes:     []v1alpha1.KubernetesNode{{ID: "a", Status: running}, {ID: "b", Status: running, PendingRecycle: true}},
+			},
+			want: "",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, NextNodeToRecycle(tc.args))
+		})
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	options := &godo.KubernetesOptions{
+		Versions: []*godo.KubernetesVersion{
+			{Slug: "1.21.5-do.0", KubernetesVersion: "1.21.5"},
+			{Slug: "1.22.2-do.0", KubernetesVersion: "1.22.2"},
+			{Slug: "1.21.10-do.0", KubernetesVersion: "1.21.10"},
+			{Slug: "1.21.10-do.1", KubernetesVersion: "1.21.10"},
+		},
+	}
+
+	tests := map[string]struct {
+		version string
+		want    string
+		wantErr bool
+	}{
+		"Slug":          {version: "1.21.5-do.0", want: "1.21.5-do.0"},
+		"Latest":        {version: "latest", want: "1.22.2-do.0"},
+		"Minor":         {version: "1.21", want: "1.21.10-do.1"},
+		"Patch":         {version: "1.21.5", want: "1.21.5-do.0"},
+		"NotAvailable":  {version: "1.20", wantErr: true},
+		"NoPrefixMatch": {version: "1.2", wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, err := ResolveVersion(tc.version, options)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, r)
+		})
+	}
+}
+
+func TestValidateParameters(t *testing.T) {
+	options := &godo.KubernetesOptions{
+		Versions: []*godo.KubernetesVersion{{Slug: "1.22.2-do.0", KubernetesVersion: "1.22.2"}},
+		Regions:  []*godo.KubernetesRegion{{Slug: "nyc1"}},
+		Sizes:    []*godo.KubernetesNodeSize{{Slug: "s-1vcpu-2gb"}},
+	}
+
+	tests := map[string]struct {
+		params  v1alpha1.DOKubernetesClusterParameters
+		want    string
+		wantErr bool
+	}{
+		"Valid": {
+			params: v1alpha1.DOKubernetesClusterParameters{
+				Region:    "nyc1",
+				Version:   "latest",
+				NodePools: []v1alpha1.KubernetesNodePool{{Name: "pool", Size: "s-1vcpu-2gb"}},
+			},
+			want: "1.22.2-do.0",
+		},
+		"InvalidRegion": {
+			params: v1alpha1.DOKubernetesClusterParameters{
+				Region:  "mars1",
+				Version: "latest",
+			},
+			wantErr: true,
+		},
+		"InvalidSize": {
+			params: v1alpha1.DOKubernetesClusterParameters{
+				Region:    "nyc1",
+				Version:   "latest",
+				NodePools: []v1alpha1.KubernetesNodePool{{Name: "pool", Size: "s-huge"}},
+			},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, err := ValidateParameters(tc.params, options)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, r)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+)
+
+// this ensures that the mock implements the client interface
+var _ godo.VPCsService = (*MockVPCsService)(nil)
+
+// MockVPCsService is a type that implements the methods of godo.VPCsService
+// used by the controllers of the vpc API group. Calling any other method
+// panics.
+type MockVPCsService struct {
+	godo.VPCsService
+
+	MockGet         func(context.Context, string) (*godo.VPC, *godo.Response, error)
+	MockCreate      func(context.Context, *godo.VPCCreateRequest) (*godo.VPC, *godo.Response, error)
+	MockSet         func(context.Context, string, ...godo.VPCSetField) (*godo.VPC, *godo.Response, error)
+	MockListMembers func(context.Context, string, *godo.VPCListMembersRequest, *godo.ListOptions) ([]*godo.VPCMember, *godo.Response, error)
+	MockDelete      func(context.Context, string) (*godo.Response, error)
+}
+
+// Get mocks Get method
+func (c *MockVPCsService) Get(ctx context.Context, id string) (*godo.VPC, *godo.Response, error) {
+	return c.MockGet(ctx, id)
+}
+
+// Create mocks Create method
+func (c *MockVPCsService) Create(ctx context.Context, create *godo.VPCCreateRequest) (*godo.VPC, *godo.Response, error) {
+	return c.MockCreate(ctx, create)
+}
+
+// Set mocks Set method
+func (c *MockVPCsService) Set(ctx context.Context, id string, fields ...godo.VPCSetField) (*godo.VPC, *godo.Response, error) {
+	return c.MockSet(ctx, id, fields...)
+}
+
+// ListMembers mocks ListMembers method
+func (c *MockVPCsService) ListMembers(ctx context.Context, id string, request *godo.VPCListMembersRequest, opt *godo.ListOptions) ([]*godo.VPCMember, *godo.Response, error) {
+	return c.MockListMembers(ctx, id, request, opt)
+}
+
+// Delete mocks Delete method
+func (c *MockVPCsService) Delete(ctx context.Context, id string) (*godo.Response, error) {
+	return c.MockDelete(ctx, id)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/vpc/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+)
+
+const (
+	msgHasMembers = "VPC still has %d member(s) that must be removed or moved to another VPC before it can be deleted: %s"
+)
+
+// GenerateVPC generates *godo.VPCCreateRequest instance from VPCParameters.
+// The supplied name is used unless the VPCParameters specify one.
+func GenerateVPC(name string, in v1alpha1.VPCParameters) *godo.VPCCreateRequest {
+	if in.Name != nil {
+		name = *in.Name
+	}
+	return &godo.VPCCreateRequest{
+		Name:        name,
+		RegionSlug:  in.Region,
+		IPRange:     do.StringValue(in.IPRange),
+		Description: do.StringValue(in.Description),
+	}
+}
+
+// GenerateVPCObservation generates a VPCObservation from the supplied
+// godo.VPC.
+func GenerateVPCObservation(observed godo.VPC) v1alpha1.VPCObservation {
+	return v1alpha1.VPCObservation{
+		ID:          observed.ID,
+		URN:         observed.URN,
+		Name:        observed.Name,
+		Region:      observed.RegionSlug,
+		IPRange:     observed.IPRange,
+		Description: observed.Description,
+		Default:     observed.Default,
+		CreatedAt:   observed.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// VPCLateInitializeSpec updates any unset (i.e. nil) optional fields of the
+// supplied VPCParameters that are set (i.e. non-zero) on the supplied VPC.
+// Default is not late-initialized, so that a VPC that is observed as the
+// default does not take it back once another VPC became the default.
+func VPCLateInitializeSpec(p *v1alpha1.VPCParameters, observed godo.VPC) {
+	p.Name = do.LateInitializeString(p.Name, observed.Name)
+	p.IPRange = do.LateInitializeString(p.IPRange, observed.IPRange)
+	p.Description = do.LateInitializeString(p.Description, observed.Description)
+}
+
+// GenerateVPCUpdate returns the fields of the supplied observed VPC that must
+// be set to match the supplied VPCParameters. The VPC is up to date if none are
+// returned. A VPC stops being the default VPC of its region only when
+// another VPC becomes the default, so an unset Default is never updated.
+func GenerateVPCUpdate(p v1alpha1.VPCParameters, observed v1alpha1.VPCObservation) []godo.VPCSetField {
+	var fields []godo.VPCSetField
+	if p.Name != nil && *p.Name != observed.Name {
+		fields = append(fields, godo.VPCSetName(*p.Name))
+	}
+	if p.Description != nil && *p.Description != observed.Description {
+		fields = append(fields, godo.VPCSetDescription(*p.Description))
+	}
+	if do.BoolValue(p.Default) && !observed.Default {
+		fields = append(fields, godo.VPCSetDefault())
+	}
+	return fields
+}
+
+// MembersMessage returns a message that describes the supplied members of a
+// VPC, which has the supplied total number of members.
+func MembersMessage(members []*godo.VPCMember, total int) string {
+	if total < len(members) {
+		total = len(members)
+	}
+	names := make([]string, len(members))
+	for i, m := range members {
+		names[i] = m.Name
+		if names[i] == "" {
+			names[i] = m.URN
+		}
+	}
+	return fmt.Sprintf(msgHasMembers, total, strings.Join(names, ", "))
+}
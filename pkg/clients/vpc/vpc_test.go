@@ -0,0 +1,62 @@
+package vpc
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/vpc/v1alpha1"
+)
+
+func TestGenerateVPCUpdate(t *testing.T) {
+	name := "mock-vpc"
+	description := "mock-description"
+	newName := "new-vpc"
+	empty := ""
+	yes, no := true, false
+	observed := v1alpha1.VPCObservation{Name: name, Description: description}
+	tests := map[string]struct {
+		params v1alpha1.VPCParameters
+		want   []godo.VPCSetField
+	}{
+		"UpToDate": {
+			params: v1alpha1.VPCParameters{Name: &name, Description: &description},
+		},
+		"Unset": {
+			params: v1alpha1.VPCParameters{},
+		},
+		"NotDefault": {
+			params: v1alpha1.VPCParameters{Default: &no},
+		},
+		"Changed": {
+			params: v1alpha1.VPCParameters{
+				Name:        &newName,
+				Description: &empty,
+				Default:     &yes,
+			},
+			want: []godo.VPCSetField{godo.VPCSetName("new-vpc"), godo.VPCSetDescription(""), godo.VPCSetDefault()},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, GenerateVPCUpdate(tc.params, observed))
+		})
+	}
+}
+
+func TestVPCLateInitializeSpec(t *testing.T) {
+	p := v1alpha1.VPCParameters{}
+	VPCLateInitializeSpec(&p, godo.VPC{Name: "mock-vpc", IPRange: "10.10.10.0/24", Default: true})
+	assert.Equal(t, v1alpha1.VPCParameters{Name: godo.String("mock-vpc"), IPRange: godo.String("10.10.10.0/24")}, p)
+}
+
+func TestMembersMessage(t *testing.T) {
+	members := []*godo.VPCMember{
+		{Name: "mock-droplet", URN: "do:droplet:1"},
+		{URN: "do:loadbalancer:mock-lb"},
+	}
+	assert.Equal(t,
+		"VPC still has 3 member(s) that must be removed or moved to another VPC before it can be deleted: mock-droplet, do:loadbalancer:mock-lb",
+		MembersMessage(members, 3))
+}
@@ -103,12 +103,14 @@ func (c *dropletExternal) Observe(ctx context.Context, mg resource.Managed) (man
 	docompute.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
 	observedPrivateIPv4, _ := observed.PrivateIPv4()
 	observedPublicIPv4, _ := observed.PublicIPv4()
+	observedPublicIPv6, _ := observed.PublicIPv6()
 
 	cr.Status.AtProvider = v1alpha1.DropletObservation{
 		CreationTimestamp: observed.Created,
 		ID:                observed.ID,
 		PrivateIPv4:       observedPrivateIPv4,
 		PublicIPv4:        observedPublicIPv4,
+		PublicIPv6:        observedPublicIPv6,
 		Region:            observed.Region.Slug,
 		Size:              observed.SizeSlug,
 		Status:            observed.Status,
@@ -0,0 +1,221 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+	docompute "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute"
+)
+
+const (
+	// Error strings.
+	errNotDropletSnapshot   = "managed resource is not a DropletSnapshot resource"
+	errGetSnapshot          = "cannot get snapshot"
+	errListDropletSnapshots = "cannot list snapshots of droplet"
+	errGetSnapshotAction    = "cannot get snapshot action of droplet"
+
+	errDropletSnapshotCreateFailed = "creation of DropletSnapshot resource has failed"
+	errDropletSnapshotUpdate       = "cannot update managed DropletSnapshot resource"
+	errSnapshotDeleteFailed        = "deletion of snapshot has failed"
+	errSnapshotInProgress          = "cannot delete a snapshot that is still in progress"
+
+	// listPageSize is the number of snapshots requested per page.
+	listPageSize = 200
+)
+
+// SetupDropletSnapshot adds a controller that reconciles DropletSnapshot
+// managed resources.
+func SetupDropletSnapshot(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DropletSnapshotGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DropletSnapshot{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DropletSnapshotGroupVersionKind),
+			managed.WithExternalConnecter(&dropletSnapshotConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type dropletSnapshotConnector struct {
+	kube client.Client
+}
+
+func (c *dropletSnapshotConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &dropletSnapshotExternal{Client: client, kube: c.kube}, nil
+}
+
+type dropletSnapshotExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+func (c *dropletSnapshotExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DropletSnapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDropletSnapshot)
+	}
+
+	// Droplet snapshots are taken asynchronously, and their ID is only known
+	// once the snapshot action completed.
+	if meta.GetExternalName(cr) == "" {
+		dropletID, err := docompute.ParseDropletID(cr.Spec.ForProvider.DropletID)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		image, err := c.findSnapshot(ctx, dropletID, cr.GetName())
+		if err != nil || image == nil {
+			return c.observePendingSnapshot(ctx, cr, dropletID, err)
+		}
+		meta.SetExternalName(cr, strconv.Itoa(image.ID))
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errDropletSnapshotUpdate)
+		}
+	}
+
+	observed, response, err := c.Snapshots.Get(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(do.IgnoreNotFound(err, response), errGetSnapshot)
+	}
+
+	cr.Status.AtProvider = docompute.GenerateSnapshotObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	// Snapshots are always "up to date" because they can't be updated.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// findSnapshot returns the snapshot image with the supplied name of the
+// Droplet with the supplied ID, or nil if the Droplet has no such snapshot.
+func (c *dropletSnapshotExternal) findSnapshot(ctx context.Context, dropletID int, name string) (*godo.Image, error) {
+	opts := &godo.ListOptions{Page: 1, PerPage: listPageSize}
+	for {
+		images, response, err := c.Droplets.Snapshots(ctx, dropletID, opts)
+		if err != nil {
+			return nil, errors.Wrap(do.IgnoreNotFound(err, response), errListDropletSnapshots)
+		}
+		if image := docompute.FindSnapshotImage(images, name); image != nil {
+			return image, nil
+		}
+		if response == nil || response.Links == nil || response.Links.IsLastPage() {
+			return nil, nil
+		}
+		opts.Page++
+	}
+}
+
+// observePendingSnapshot reports the snapshot of the supplied DropletSnapshot
+// as existing until the snapshot action started by its Create errored or is
+// gone, so that the snapshot is taken again only then.
+func (c *dropletSnapshotExternal) observePendingSnapshot(ctx context.Context, cr *v1alpha1.DropletSnapshot, dropletID int, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	id, err := strconv.Atoi(cr.GetAnnotations()[docompute.AnnotationKeySnapshotAction])
+	if err != nil {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	action, response, err := c.DropletActions.Get(ctx, dropletID, id)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(do.IgnoreNotFound(err, response), errGetSnapshotAction)
+	}
+	if action == nil || !docompute.SnapshotPending(*action) {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (c *dropletSnapshotExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DropletSnapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDropletSnapshot)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	dropletID, err := docompute.ParseDropletID(cr.Spec.ForProvider.DropletID)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	action, _, err := c.DropletActions.Snapshot(ctx, dropletID, cr.GetName())
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errDropletSnapshotCreateFailed)
+	}
+	meta.AddAnnotations(cr, map[string]string{docompute.AnnotationKeySnapshotAction: strconv.Itoa(action.ID)})
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *dropletSnapshotExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// Snapshots cannot be updated.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *dropletSnapshotExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DropletSnapshot)
+	if !ok {
+		return errors.New(errNotDropletSnapshot)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// The snapshot is deleted once it completed and its ID is known.
+	if meta.GetExternalName(cr) == "" {
+		return errors.New(errSnapshotInProgress)
+	}
+
+	response, err := c.Snapshots.Delete(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(do.IgnoreNotFound(err, response), errSnapshotDeleteFailed)
+}
@@ -0,0 +1,308 @@
+package compute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	docompute "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute/fake"
+)
+
+const (
+	dropletSnapshotName = "golden"
+	snapshotID          = "42"
+	snapshotActionID    = "7"
+)
+
+type dropletSnapshotModifier func(*v1alpha1.DropletSnapshot)
+
+func withDropletSnapshotExternalName(n string) dropletSnapshotModifier {
+	return func(cr *v1alpha1.DropletSnapshot) { meta.SetExternalName(cr, n) }
+}
+
+func withDropletSnapshotAction(id string) dropletSnapshotModifier {
+	return func(cr *v1alpha1.DropletSnapshot) {
+		meta.AddAnnotations(cr, map[string]string{docompute.AnnotationKeySnapshotAction: id})
+	}
+}
+
+func withDropletSnapshotStatus(o v1alpha1.SnapshotObservation) dropletSnapshotModifier {
+	return func(cr *v1alpha1.DropletSnapshot) { cr.Status.AtProvider = o }
+}
+
+func withDropletSnapshotConditions(c ...xpv1.Condition) dropletSnapshotModifier {
+	return func(cr *v1alpha1.DropletSnapshot) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func dropletSnapshot(m ...dropletSnapshotModifier) *v1alpha1.DropletSnapshot {
+	cr := &v1alpha1.DropletSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: dropletSnapshotName,
+		},
+		Spec: v1alpha1.DropletSnapshotSpec{
+			ForProvider: v1alpha1.DropletSnapshotParameters{
+				DropletID: godo.String("123"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func Test_dropletSnapshotExternal_Observe(t *testing.T) {
+	snapshot := &godo.Snapshot{ID: snapshotID, Name: dropletSnapshotName, SizeGigaBytes: 2.5}
+	type args struct {
+		cr         *v1alpha1.DropletSnapshot
+		images     []godo.Image
+		action     *godo.Action
+		actionErr  error
+		actionResp *godo.Response
+		getErr     error
+		getResp    *godo.Response
+	}
+	type want struct {
+		cr     *v1alpha1.DropletSnapshot
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"NotCreated": {
+			args: args{
+				cr: dropletSnapshot(),
+			},
+			want: want{
+				cr: dropletSnapshot(),
+			},
+		},
+		"SnapshotInProgress": {
+			args: args{
+				cr:     dropletSnapshot(withDropletSnapshotAction(snapshotActionID)),
+				action: &godo.Action{ID: 7, Type: docompute.ActionTypeSnapshot, Status: godo.ActionInProgress},
+			},
+			want: want{
+				cr:     dropletSnapshot(withDropletSnapshotAction(snapshotActionID), withDropletSnapshotConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SnapshotCompletedNotListed": {
+			args: args{
+				cr:     dropletSnapshot(withDropletSnapshotAction(snapshotActionID)),
+				action: &godo.Action{ID: 7, Type: docompute.ActionTypeSnapshot, Status: godo.ActionCompleted},
+			},
+			want: want{
+				cr:     dropletSnapshot(withDropletSnapshotAction(snapshotActionID), withDropletSnapshotConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SnapshotErrored": {
+			args: args{
+				cr:     dropletSnapshot(withDropletSnapshotAction(snapshotActionID)),
+				action: &godo.Action{ID: 7, Type: docompute.ActionTypeSnapshot, Status: docompute.ActionStatusErrored},
+			},
+			want: want{
+				cr: dropletSnapshot(withDropletSnapshotAction(snapshotActionID)),
+			},
+		},
+		"SnapshotActionGone": {
+			args: args{
+				cr:         dropletSnapshot(withDropletSnapshotAction(snapshotActionID)),
+				actionErr:  errBoom,
+				actionResp: notFound(),
+			},
+			want: want{
+				cr: dropletSnapshot(withDropletSnapshotAction(snapshotActionID)),
+			},
+		},
+		"GetSnapshotActionFailed": {
+			args: args{
+				cr:        dropletSnapshot(withDropletSnapshotAction(snapshotActionID)),
+				actionErr: errBoom,
+			},
+			want: want{
+				cr:  dropletSnapshot(withDropletSnapshotAction(snapshotActionID)),
+				err: errors.Wrap(errBoom, errGetSnapshotAction),
+			},
+		},
+		"SnapshotTaken": {
+			args: args{
+				cr:     dropletSnapshot(withDropletSnapshotAction(snapshotActionID)),
+				images: []godo.Image{{ID: 42, Name: dropletSnapshotName}},
+			},
+			want: want{
+				cr: dropletSnapshot(withDropletSnapshotAction(snapshotActionID), withDropletSnapshotExternalName(snapshotID),
+					withDropletSnapshotStatus(docompute.GenerateSnapshotObservation(*snapshot)),
+					withDropletSnapshotConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SnapshotDeleted": {
+			args: args{
+				cr:      dropletSnapshot(withDropletSnapshotExternalName(snapshotID)),
+				getErr:  errBoom,
+				getResp: notFound(),
+			},
+			want: want{
+				cr: dropletSnapshot(withDropletSnapshotExternalName(snapshotID)),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				cr:     dropletSnapshot(withDropletSnapshotExternalName(snapshotID)),
+				getErr: errBoom,
+			},
+			want: want{
+				cr:  dropletSnapshot(withDropletSnapshotExternalName(snapshotID)),
+				err: errors.Wrap(errBoom, errGetSnapshot),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &dropletSnapshotExternal{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				Client: &godo.Client{
+					Droplets: &fake.MockDropletsService{
+						MockSnapshots: func(context.Context, int, *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+							return tc.args.images, nil, nil
+						},
+					},
+					DropletActions: &fake.MockDropletActionsService{
+						MockGet: func(_ context.Context, droplet, id int) (*godo.Action, *godo.Response, error) {
+							if droplet != dropletID || id != 7 {
+								t.Errorf("unexpected action %d of droplet %d observed", id, droplet)
+							}
+							return tc.args.action, tc.args.actionResp, tc.args.actionErr
+						},
+					},
+					Snapshots: &fake.MockSnapshotsService{
+						MockGet: func(_ context.Context, id string) (*godo.Snapshot, *godo.Response, error) {
+							if tc.args.getErr != nil {
+								return nil, tc.args.getResp, tc.args.getErr
+							}
+							return snapshot, nil, nil
+						},
+					},
+				},
+			}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_dropletSnapshotExternal_Create(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DropletSnapshot
+		err error
+	}
+	tests := map[string]struct {
+		snapshotErr error
+		want        want
+	}{
+		"Created": {
+			want: want{
+				cr: dropletSnapshot(withDropletSnapshotAction(snapshotActionID), withDropletSnapshotConditions(xpv1.Creating())),
+			},
+		},
+		"SnapshotFailed": {
+			snapshotErr: errBoom,
+			want: want{
+				cr:  dropletSnapshot(withDropletSnapshotConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errDropletSnapshotCreateFailed),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &dropletSnapshotExternal{Client: &godo.Client{DropletActions: &fake.MockDropletActionsService{
+				MockSnapshot: func(_ context.Context, droplet int, name string) (*godo.Action, *godo.Response, error) {
+					if droplet != dropletID || name != dropletSnapshotName {
+						t.Errorf("unexpected snapshot %s of droplet %d", name, droplet)
+					}
+					if tc.snapshotErr != nil {
+						return nil, nil, tc.snapshotErr
+					}
+					return &godo.Action{ID: 7, Type: docompute.ActionTypeSnapshot, Status: godo.ActionInProgress}, nil, nil
+				},
+			}}}
+			cr := dropletSnapshot()
+			_, err := e.Create(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_dropletSnapshotExternal_Delete(t *testing.T) {
+	type args struct {
+		cr         *v1alpha1.DropletSnapshot
+		deleteErr  error
+		deleteResp *godo.Response
+	}
+	tests := map[string]struct {
+		args args
+		want error
+	}{
+		"InProgress": {
+			args: args{cr: dropletSnapshot(withDropletSnapshotAction(snapshotActionID))},
+			want: errors.New(errSnapshotInProgress),
+		},
+		"Deleted": {
+			args: args{cr: dropletSnapshot(withDropletSnapshotExternalName(snapshotID))},
+		},
+		"AlreadyGone": {
+			args: args{cr: dropletSnapshot(withDropletSnapshotExternalName(snapshotID)), deleteErr: errBoom, deleteResp: notFound()},
+		},
+		"DeleteFailed": {
+			args: args{cr: dropletSnapshot(withDropletSnapshotExternalName(snapshotID)), deleteErr: errBoom},
+			want: errors.Wrap(errBoom, errSnapshotDeleteFailed),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &dropletSnapshotExternal{Client: &godo.Client{Snapshots: &fake.MockSnapshotsService{
+				MockDelete: func(_ context.Context, id string) (*godo.Response, error) {
+					if id != snapshotID {
+						t.Errorf("unexpected snapshot %s deleted", id)
+					}
+					return tc.args.deleteResp, tc.args.deleteErr
+				},
+			}}}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
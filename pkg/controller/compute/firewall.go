@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+	docompute "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute"
+)
+
+const (
+	// Error strings.
+	errNotFirewall          = "managed resource is not a Firewall resource"
+	errGetFirewall          = "cannot get firewall"
+	errInvalidFirewall      = "invalid Firewall"
+	errFirewallCreateFailed = "creation of Firewall resource has failed"
+	errFirewallDeleteFailed = "deletion of Firewall resource has failed"
+	errFirewallUpdate       = "cannot update Firewall"
+
+	// Condition messages.
+	msgFirewallPending = "firewall changes are pending"
+	msgFirewallFailed  = "firewall changes failed"
+)
+
+// SetupFirewall adds a controller that reconciles Firewall managed
+// resources.
+func SetupFirewall(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.FirewallGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Firewall{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FirewallGroupVersionKind),
+			managed.WithExternalConnecter(&firewallConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type firewallConnector struct {
+	kube client.Client
+}
+
+func (c *firewallConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &firewallExternal{Client: client, kube: c.kube}, nil
+}
+
+type firewallExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+func (c *firewallExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Firewall)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFirewall)
+	}
+	if meta.GetExternalName(cr) == "" {
+		if _, err := c.generateFirewall(cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, response, err := c.Firewalls.Get(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(do.IgnoreNotFound(err, response), errGetFirewall)
+	}
+
+	cr.Status.AtProvider = docompute.GenerateFirewallObservation(*observed)
+
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.FirewallStatusSucceeded:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.FirewallStatusWaiting:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgFirewallPending))
+	case v1alpha1.FirewallStatusFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgFirewallFailed))
+	}
+
+	// Invalid parameters are never up to date so that they are rejected by
+	// Update.
+	desired, err := c.generateFirewall(cr)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: err == nil && docompute.FirewallIsUpToDate(desired, *observed),
+	}, nil
+}
+
+func (c *firewallExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Firewall)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFirewall)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	create, err := c.generateFirewall(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	firewall, _, err := c.Firewalls.Create(ctx, create)
+	if err != nil || firewall == nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errFirewallCreateFailed)
+	}
+
+	meta.SetExternalName(cr, firewall.ID)
+
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *firewallExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Firewall)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFirewall)
+	}
+
+	update, err := c.generateFirewall(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// The rules, Droplets and tags of a Firewall are replaced in place.
+	_, _, err = c.Firewalls.Update(ctx, meta.GetExternalName(cr), update)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errFirewallUpdate)
+}
+
+// generateFirewall generates the desired Firewall of the supplied Firewall
+// resource, and records whether its parameters were rejected.
+func (c *firewallExternal) generateFirewall(cr *v1alpha1.Firewall) (*godo.FirewallRequest, error) {
+	req, err := docompute.GenerateFirewall(cr.GetName(), cr.Spec.ForProvider)
+	return req, do.RecordValidation(cr, err, errInvalidFirewall)
+}
+
+func (c *firewallExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Firewall)
+	if !ok {
+		return errors.New(errNotFirewall)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	response, err := c.Firewalls.Delete(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(do.IgnoreNotFound(err, response), errFirewallDeleteFailed)
+}
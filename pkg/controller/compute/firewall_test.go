@@ -0,0 +1,389 @@
+package compute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	dov1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/v1alpha1"
+	docompute "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute/fake"
+)
+
+const (
+	firewallName = "web"
+	firewallID   = "fw-1"
+)
+
+type firewallModifier func(*v1alpha1.Firewall)
+
+func withFirewallSpec(p v1alpha1.FirewallParameters) firewallModifier {
+	return func(cr *v1alpha1.Firewall) { cr.Spec.ForProvider = p }
+}
+
+func withFirewallExternalName(n string) firewallModifier {
+	return func(cr *v1alpha1.Firewall) { meta.SetExternalName(cr, n) }
+}
+
+func withFirewallStatus(o v1alpha1.FirewallObservation) firewallModifier {
+	return func(cr *v1alpha1.Firewall) { cr.Status.AtProvider = o }
+}
+
+func withFirewallConditions(c ...xpv1.Condition) firewallModifier {
+	return func(cr *v1alpha1.Firewall) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func firewall(m ...firewallModifier) *v1alpha1.Firewall {
+	cr := &v1alpha1.Firewall{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: firewallName,
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func Test_firewallExternal_Observe(t *testing.T) {
+	valid := v1alpha1.FirewallParameters{
+		DropletIDs: []string{"123"},
+		InboundRules: []v1alpha1.FirewallInboundRule{{
+			Protocol:  "tcp",
+			PortRange: godo.String("22"),
+			Sources:   v1alpha1.FirewallTargets{Addresses: []string{"0.0.0.0/0"}},
+		}},
+	}
+	invalid := v1alpha1.FirewallParameters{DropletIDs: []string{"web-1"}}
+	_, errInvalid := docompute.GenerateFirewall(firewallName, invalid)
+	retagged := valid
+	retagged.Tags = []string{"web"}
+	observed := &godo.Firewall{
+		ID:         firewallID,
+		Name:       firewallName,
+		Status:     v1alpha1.FirewallStatusSucceeded,
+		DropletIDs: []int{123},
+		InboundRules: []godo.InboundRule{{
+			Protocol:  "tcp",
+			PortRange: "22",
+			Sources:   &godo.Sources{Addresses: []string{"0.0.0.0/0"}},
+		}},
+	}
+
+	type args struct {
+		cr      *v1alpha1.Firewall
+		getErr  error
+		getResp *godo.Response
+	}
+	type want struct {
+		cr     *v1alpha1.Firewall
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"NotCreated": {
+			args: args{
+				cr: firewall(withFirewallSpec(valid)),
+			},
+			want: want{
+				cr: firewall(withFirewallSpec(valid)),
+			},
+		},
+		"NotCreatedRejected": {
+			args: args{
+				cr: firewall(withFirewallSpec(invalid)),
+			},
+			want: want{
+				cr:  firewall(withFirewallSpec(invalid), withFirewallConditions(dov1alpha1.ParametersRejected(errInvalid))),
+				err: errors.Wrap(errInvalid, errInvalidFirewall),
+			},
+		},
+		"NotCreatedCorrected": {
+			args: args{
+				cr: firewall(withFirewallSpec(valid), withFirewallConditions(dov1alpha1.ParametersRejected(errInvalid))),
+			},
+			want: want{
+				cr: firewall(withFirewallSpec(valid), withFirewallConditions(dov1alpha1.ParametersAccepted())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cr:      firewall(withFirewallSpec(valid), withFirewallExternalName(firewallID)),
+				getErr:  errBoom,
+				getResp: notFound(),
+			},
+			want: want{
+				cr: firewall(withFirewallSpec(valid), withFirewallExternalName(firewallID)),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				cr:     firewall(withFirewallSpec(valid), withFirewallExternalName(firewallID)),
+				getErr: errBoom,
+			},
+			want: want{
+				cr:  firewall(withFirewallSpec(valid), withFirewallExternalName(firewallID)),
+				err: errors.Wrap(errBoom, errGetFirewall),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				cr: firewall(withFirewallSpec(valid), withFirewallExternalName(firewallID)),
+			},
+			want: want{
+				cr: firewall(withFirewallSpec(valid), withFirewallExternalName(firewallID),
+					withFirewallStatus(docompute.GenerateFirewallObservation(*observed)),
+					withFirewallConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"OutOfDate": {
+			args: args{
+				cr: firewall(withFirewallSpec(retagged), withFirewallExternalName(firewallID)),
+			},
+			want: want{
+				cr: firewall(withFirewallSpec(retagged), withFirewallExternalName(firewallID),
+					withFirewallStatus(docompute.GenerateFirewallObservation(*observed)),
+					withFirewallConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Rejected": {
+			args: args{
+				cr: firewall(withFirewallSpec(invalid), withFirewallExternalName(firewallID)),
+			},
+			want: want{
+				cr: firewall(withFirewallSpec(invalid), withFirewallExternalName(firewallID),
+					withFirewallStatus(docompute.GenerateFirewallObservation(*observed)),
+					withFirewallConditions(xpv1.Available(), dov1alpha1.ParametersRejected(errInvalid))),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &firewallExternal{Client: &godo.Client{Firewalls: &fake.MockFirewallsService{
+				MockGet: func(_ context.Context, id string) (*godo.Firewall, *godo.Response, error) {
+					if tc.args.getErr != nil {
+						return nil, tc.args.getResp, tc.args.getErr
+					}
+					return observed, nil, nil
+				},
+			}}}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_firewallExternal_Create(t *testing.T) {
+	valid := v1alpha1.FirewallParameters{DropletIDs: []string{"123"}, Tags: []string{"web"}}
+	invalid := v1alpha1.FirewallParameters{DropletIDs: []string{"web-1"}}
+	_, errInvalid := docompute.GenerateFirewall(firewallName, invalid)
+
+	type args struct {
+		cr        *v1alpha1.Firewall
+		createErr error
+	}
+	type want struct {
+		cr     *v1alpha1.Firewall
+		result managed.ExternalCreation
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"Created": {
+			args: args{
+				cr: firewall(withFirewallSpec(valid)),
+			},
+			want: want{
+				cr:     firewall(withFirewallSpec(valid), withFirewallExternalName(firewallID), withFirewallConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"Rejected": {
+			args: args{
+				cr: firewall(withFirewallSpec(invalid)),
+			},
+			want: want{
+				cr:  firewall(withFirewallSpec(invalid), withFirewallConditions(xpv1.Creating(), dov1alpha1.ParametersRejected(errInvalid))),
+				err: errors.Wrap(errInvalid, errInvalidFirewall),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				cr:        firewall(withFirewallSpec(valid)),
+				createErr: errBoom,
+			},
+			want: want{
+				cr:  firewall(withFirewallSpec(valid), withFirewallConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errFirewallCreateFailed),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &firewallExternal{Client: &godo.Client{Firewalls: &fake.MockFirewallsService{
+				MockCreate: func(_ context.Context, create *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error) {
+					want := &godo.FirewallRequest{Name: firewallName, InboundRules: []godo.InboundRule{}, OutboundRules: []godo.OutboundRule{}, DropletIDs: []int{123}, Tags: []string{"web"}}
+					if diff := cmp.Diff(want, create); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if tc.args.createErr != nil {
+						return nil, nil, tc.args.createErr
+					}
+					return &godo.Firewall{ID: firewallID}, nil, nil
+				},
+			}}}
+			c, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, c); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_firewallExternal_Update(t *testing.T) {
+	valid := v1alpha1.FirewallParameters{Tags: []string{"web"}}
+	invalid := v1alpha1.FirewallParameters{DropletIDs: []string{"web-1"}}
+	_, errInvalid := docompute.GenerateFirewall(firewallName, invalid)
+
+	type args struct {
+		cr        *v1alpha1.Firewall
+		updateErr error
+	}
+	type want struct {
+		cr     *v1alpha1.Firewall
+		update *godo.FirewallRequest
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"Updated": {
+			args: args{
+				cr: firewall(withFirewallSpec(valid), withFirewallExternalName(firewallID)),
+			},
+			want: want{
+				cr:     firewall(withFirewallSpec(valid), withFirewallExternalName(firewallID)),
+				update: &godo.FirewallRequest{Name: firewallName, InboundRules: []godo.InboundRule{}, OutboundRules: []godo.OutboundRule{}, Tags: []string{"web"}},
+			},
+		},
+		"Rejected": {
+			args: args{
+				cr: firewall(withFirewallSpec(invalid), withFirewallExternalName(firewallID)),
+			},
+			want: want{
+				cr:  firewall(withFirewallSpec(invalid), withFirewallExternalName(firewallID), withFirewallConditions(dov1alpha1.ParametersRejected(errInvalid))),
+				err: errors.Wrap(errInvalid, errInvalidFirewall),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				cr:        firewall(withFirewallSpec(valid), withFirewallExternalName(firewallID)),
+				updateErr: errBoom,
+			},
+			want: want{
+				cr:     firewall(withFirewallSpec(valid), withFirewallExternalName(firewallID)),
+				update: &godo.FirewallRequest{Name: firewallName, InboundRules: []godo.InboundRule{}, OutboundRules: []godo.OutboundRule{}, Tags: []string{"web"}},
+				err:    errors.Wrap(errBoom, errFirewallUpdate),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var update *godo.FirewallRequest
+			e := &firewallExternal{Client: &godo.Client{Firewalls: &fake.MockFirewallsService{
+				MockUpdate: func(_ context.Context, id string, r *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error) {
+					if id != firewallID {
+						t.Errorf("unexpected firewall %s updated", id)
+					}
+					update = r
+					return &godo.Firewall{ID: id}, nil, tc.args.updateErr
+				},
+			}}}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.update, update); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_firewallExternal_Delete(t *testing.T) {
+	type args struct {
+		deleteErr  error
+		deleteResp *godo.Response
+	}
+	tests := map[string]struct {
+		args args
+		want error
+	}{
+		"Deleted": {
+			args: args{},
+		},
+		"AlreadyGone": {
+			args: args{deleteErr: errBoom, deleteResp: notFound()},
+		},
+		"DeleteFailed": {
+			args: args{deleteErr: errBoom},
+			want: errors.Wrap(errBoom, errFirewallDeleteFailed),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &firewallExternal{Client: &godo.Client{Firewalls: &fake.MockFirewallsService{
+				MockDelete: func(_ context.Context, id string) (*godo.Response, error) {
+					if id != firewallID {
+						t.Errorf("unexpected firewall %s deleted", id)
+					}
+					return tc.args.deleteResp, tc.args.deleteErr
+				},
+			}}}
+			err := e.Delete(context.Background(), firewall(withFirewallExternalName(firewallID)))
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
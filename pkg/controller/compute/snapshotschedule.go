@@ -0,0 +1,228 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+	docompute "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute"
+)
+
+const (
+	// Error strings.
+	errNotSnapshotSchedule  = "managed resource is not a SnapshotSchedule resource"
+	errInvalidSchedule      = "invalid schedule of SnapshotSchedule"
+	errListDropletResources = "cannot list Droplet resources"
+	errListDropletsByTag    = "cannot list droplets by tag"
+	errTakeSnapshot         = "cannot take snapshot"
+	errDeleteSnapshot       = "cannot delete snapshot"
+)
+
+// SetupSnapshotSchedule adds a controller that reconciles SnapshotSchedule
+// managed resources.
+func SetupSnapshotSchedule(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.SnapshotScheduleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.SnapshotSchedule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SnapshotScheduleGroupVersionKind),
+			managed.WithExternalConnecter(&snapshotScheduleConnector{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type snapshotScheduleConnector struct {
+	kube client.Client
+}
+
+func (c *snapshotScheduleConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &snapshotScheduleExternal{Client: client, kube: c.kube}, nil
+}
+
+type snapshotScheduleExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+// A SnapshotSchedule has no external resource of its own. It always exists,
+// and is out of date whenever snapshots are due.
+func (c *snapshotScheduleExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SnapshotSchedule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSnapshotSchedule)
+	}
+
+	err := docompute.ScheduleSnapshots(cr.Spec.ForProvider, &cr.Status.AtProvider, cr.GetCreationTimestamp().Time)
+	if err := do.RecordValidation(cr, err, errInvalidSchedule); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !docompute.SnapshotsDue(cr.Status.AtProvider, time.Now()),
+	}, nil
+}
+
+func (c *snapshotScheduleExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	// SnapshotSchedules always exist.
+	return managed.ExternalCreation{}, nil
+}
+
+// Update takes a snapshot of each selected Droplet and deletes its expired
+// snapshots. Failures of individual Droplets are reported in the status of
+// the schedule rather than failing the whole run.
+func (c *snapshotScheduleExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SnapshotSchedule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSnapshotSchedule)
+	}
+
+	dropletIDs, err := c.selectDroplets(ctx, cr.Spec.ForProvider.DropletSelector)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	now := time.Now()
+	name := docompute.ScheduledSnapshotName(cr.GetName(), now)
+	failures := []v1alpha1.SnapshotScheduleFailure{}
+	for _, id := range dropletIDs {
+		taken := name
+		if _, _, err := c.DropletActions.Snapshot(ctx, id, name); err != nil {
+			failures = append(failures, v1alpha1.SnapshotScheduleFailure{DropletID: id, Message: errors.Wrap(err, errTakeSnapshot).Error()})
+			taken = ""
+		}
+		if err := c.pruneSnapshots(ctx, cr.Spec.ForProvider.Retention, id, cr.GetName(), taken, now); err != nil {
+			failures = append(failures, v1alpha1.SnapshotScheduleFailure{DropletID: id, Message: err.Error()})
+		}
+	}
+
+	last := metav1.NewTime(now)
+	cr.Status.AtProvider.LastRunAt = &last
+	cr.Status.AtProvider.DropletIDs = dropletIDs
+	cr.Status.AtProvider.Failures = failures
+	if err := docompute.ScheduleSnapshots(cr.Spec.ForProvider, &cr.Status.AtProvider, cr.GetCreationTimestamp().Time); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidSchedule)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// selectDroplets returns the sorted IDs of the Droplets selected by the
+// supplied selector.
+func (c *snapshotScheduleExternal) selectDroplets(ctx context.Context, s v1alpha1.SnapshotScheduleDropletSelector) ([]int, error) {
+	selected := map[int]bool{}
+
+	if len(s.MatchLabels) > 0 {
+		l := &v1alpha1.DropletList{}
+		if err := c.kube.List(ctx, l, client.MatchingLabels(s.MatchLabels)); err != nil {
+			return nil, errors.Wrap(err, errListDropletResources)
+		}
+		for _, d := range l.Items {
+			if d.Status.AtProvider.ID != 0 {
+				selected[d.Status.AtProvider.ID] = true
+			}
+		}
+	}
+
+	for _, tag := range s.MatchTags {
+		opts := &godo.ListOptions{Page: 1, PerPage: listPageSize}
+		for {
+			droplets, response, err := c.Droplets.ListByTag(ctx, tag, opts)
+			if err != nil {
+				return nil, errors.Wrap(err, errListDropletsByTag)
+			}
+			for _, d := range droplets {
+				selected[d.ID] = true
+			}
+			if response == nil || response.Links == nil || response.Links.IsLastPage() {
+				break
+			}
+			opts.Page++
+		}
+	}
+
+	ids := make([]int, 0, len(selected))
+	for id := range selected {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids, nil
+}
+
+// pruneSnapshots deletes the expired snapshots taken by the supplied schedule
+// of the Droplet with the supplied ID. The snapshot with the supplied name,
+// unless empty, was just requested and counts towards the snapshots to keep.
+func (c *snapshotScheduleExternal) pruneSnapshots(ctx context.Context, p *v1alpha1.SnapshotRetentionPolicy, dropletID int, schedule, taken string, now time.Time) error {
+	if p == nil {
+		return nil
+	}
+
+	var images []godo.Image
+	opts := &godo.ListOptions{Page: 1, PerPage: listPageSize}
+	for {
+		page, response, err := c.Droplets.Snapshots(ctx, dropletID, opts)
+		if err != nil {
+			return errors.Wrap(err, errListDropletSnapshots)
+		}
+		images = append(images, page...)
+		if response == nil || response.Links == nil || response.Links.IsLastPage() {
+			break
+		}
+		opts.Page++
+	}
+
+	for _, i := range docompute.ExpiredSnapshots(p, images, schedule, taken, now) {
+		if _, err := c.Snapshots.Delete(ctx, strconv.Itoa(i.ID)); err != nil {
+			return errors.Wrap(err, errDeleteSnapshot)
+		}
+	}
+	return nil
+}
+
+func (c *snapshotScheduleExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	// Snapshots taken by a schedule outlive it, they are not deleted with it.
+	return nil
+}
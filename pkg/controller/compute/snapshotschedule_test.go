@@ -0,0 +1,262 @@
+package compute
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	dov1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/v1alpha1"
+	docompute "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute/fake"
+)
+
+const scheduleName = "nightly"
+
+type scheduleModifier func(*v1alpha1.SnapshotSchedule)
+
+func withScheduleSpec(p v1alpha1.SnapshotScheduleParameters) scheduleModifier {
+	return func(cr *v1alpha1.SnapshotSchedule) { cr.Spec.ForProvider = p }
+}
+
+func withScheduleStatus(o v1alpha1.SnapshotScheduleObservation) scheduleModifier {
+	return func(cr *v1alpha1.SnapshotSchedule) { cr.Status.AtProvider = o }
+}
+
+func withScheduleConditions(c ...xpv1.Condition) scheduleModifier {
+	return func(cr *v1alpha1.SnapshotSchedule) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func snapshotSchedule(m ...scheduleModifier) *v1alpha1.SnapshotSchedule {
+	cr := &v1alpha1.SnapshotSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              scheduleName,
+			CreationTimestamp: metav1.NewTime(time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)),
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// nextRun returns the observation of a schedule that last ran at the supplied
+// time.
+func nextRun(p v1alpha1.SnapshotScheduleParameters, last time.Time) v1alpha1.SnapshotScheduleObservation {
+	l := metav1.NewTime(last)
+	o := v1alpha1.SnapshotScheduleObservation{LastRunAt: &l}
+	_ = docompute.ScheduleSnapshots(p, &o, time.Time{})
+	return o
+}
+
+func Test_snapshotScheduleExternal_Observe(t *testing.T) {
+	daily := v1alpha1.SnapshotScheduleParameters{Schedule: "0 3 * * *"}
+	invalid := v1alpha1.SnapshotScheduleParameters{Schedule: "every day"}
+	errInvalid := docompute.ScheduleSnapshots(invalid, &v1alpha1.SnapshotScheduleObservation{}, time.Time{})
+	ranNow := nextRun(daily, time.Now())
+	ranLongAgo := nextRun(daily, time.Now().AddDate(0, 0, -2))
+
+	type want struct {
+		cr     *v1alpha1.SnapshotSchedule
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		cr   *v1alpha1.SnapshotSchedule
+		want want
+	}{
+		"InvalidSchedule": {
+			cr: snapshotSchedule(withScheduleSpec(invalid)),
+			want: want{
+				cr:  snapshotSchedule(withScheduleSpec(invalid), withScheduleConditions(dov1alpha1.ParametersRejected(errInvalid))),
+				err: errors.Wrap(errInvalid, errInvalidSchedule),
+			},
+		},
+		"ScheduleCorrected": {
+			cr: snapshotSchedule(withScheduleSpec(daily), withScheduleStatus(ranNow),
+				withScheduleConditions(dov1alpha1.ParametersRejected(errInvalid))),
+			want: want{
+				cr: snapshotSchedule(withScheduleSpec(daily), withScheduleStatus(ranNow),
+					withScheduleConditions(dov1alpha1.ParametersAccepted(), xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotDue": {
+			cr: snapshotSchedule(withScheduleSpec(daily), withScheduleStatus(ranNow)),
+			want: want{
+				cr:     snapshotSchedule(withScheduleSpec(daily), withScheduleStatus(ranNow), withScheduleConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Due": {
+			cr: snapshotSchedule(withScheduleSpec(daily), withScheduleStatus(ranLongAgo)),
+			want: want{
+				cr:     snapshotSchedule(withScheduleSpec(daily), withScheduleStatus(ranLongAgo), withScheduleConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &snapshotScheduleExternal{}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_snapshotScheduleExternal_Update(t *testing.T) {
+	now := time.Now()
+	keepLast := 1
+	image := func(id int, schedule string, daysAgo int) godo.Image {
+		created := now.AddDate(0, 0, -daysAgo)
+		return godo.Image{ID: id, Name: docompute.ScheduledSnapshotName(schedule, created), Created: created.Format(time.RFC3339)}
+	}
+	spec := v1alpha1.SnapshotScheduleParameters{
+		Schedule: "0 3 * * *",
+		DropletSelector: v1alpha1.SnapshotScheduleDropletSelector{
+			MatchLabels: map[string]string{"app": "web"},
+			MatchTags:   []string{"web"},
+		},
+		Retention: &v1alpha1.SnapshotRetentionPolicy{KeepLast: &keepLast},
+	}
+	snapshots := map[int][]godo.Image{
+		1: {image(11, scheduleName, 1), image(12, scheduleName+"-prod", 2)},
+		2: {image(21, scheduleName, 1), image(22, scheduleName, 2)},
+	}
+
+	type args struct {
+		listErr     error
+		tagErr      error
+		snapshotErr map[int]error
+		deleteErr   error
+	}
+	type want struct {
+		droplets []int
+		failures []v1alpha1.SnapshotScheduleFailure
+		deleted  []int
+		err      error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"ListDropletResourcesFailed": {
+			args: args{listErr: errBoom},
+			want: want{err: errors.Wrap(errBoom, errListDropletResources)},
+		},
+		"ListDropletsByTagFailed": {
+			args: args{tagErr: errBoom},
+			want: want{err: errors.Wrap(errBoom, errListDropletsByTag)},
+		},
+		"Ran": {
+			want: want{
+				droplets: []int{1, 2, 3},
+				failures: []v1alpha1.SnapshotScheduleFailure{},
+				deleted:  []int{11, 21, 22},
+			},
+		},
+		"SnapshotFailed": {
+			args: args{snapshotErr: map[int]error{2: errBoom}},
+			want: want{
+				droplets: []int{1, 2, 3},
+				failures: []v1alpha1.SnapshotScheduleFailure{{DropletID: 2, Message: errors.Wrap(errBoom, errTakeSnapshot).Error()}},
+				deleted:  []int{11, 22},
+			},
+		},
+		"DeleteFailed": {
+			args: args{deleteErr: errBoom},
+			want: want{
+				droplets: []int{1, 2, 3},
+				failures: []v1alpha1.SnapshotScheduleFailure{
+					{DropletID: 1, Message: errors.Wrap(errBoom, errDeleteSnapshot).Error()},
+					{DropletID: 2, Message: errors.Wrap(errBoom, errDeleteSnapshot).Error()},
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var deleted []int
+			e := &snapshotScheduleExternal{
+				kube: &test.MockClient{
+					MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+						l := obj.(*v1alpha1.DropletList)
+						l.Items = []v1alpha1.Droplet{{}, {}}
+						l.Items[0].Status.AtProvider.ID = 2
+						l.Items[1].Status.AtProvider.ID = 3
+						return tc.args.listErr
+					},
+				},
+				Client: &godo.Client{
+					Droplets: &fake.MockDropletsService{
+						MockListByTag: func(context.Context, string, *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+							return []godo.Droplet{{ID: 1}, {ID: 2}}, nil, tc.args.tagErr
+						},
+						MockSnapshots: func(_ context.Context, id int, _ *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+							return snapshots[id], nil, nil
+						},
+					},
+					DropletActions: &fake.MockDropletActionsService{
+						MockSnapshot: func(_ context.Context, id int, name string) (*godo.Action, *godo.Response, error) {
+							if !docompute.IsScheduledSnapshot(name, scheduleName) {
+								t.Errorf("unexpected snapshot %s of droplet %d", name, id)
+							}
+							return &godo.Action{ID: id}, nil, tc.args.snapshotErr[id]
+						},
+					},
+					Snapshots: &fake.MockSnapshotsService{
+						MockDelete: func(_ context.Context, id string) (*godo.Response, error) {
+							if tc.args.deleteErr != nil {
+								return nil, tc.args.deleteErr
+							}
+							i, _ := strconv.Atoi(id)
+							deleted = append(deleted, i)
+							return nil, nil
+						},
+					},
+				},
+			}
+			cr := snapshotSchedule(withScheduleSpec(spec))
+			_, err := e.Update(context.Background(), cr)
+			sort.Ints(deleted)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.droplets, cr.Status.AtProvider.DropletIDs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.failures, cr.Status.AtProvider.Failures); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if err == nil && (cr.Status.AtProvider.LastRunAt == nil || !cr.Status.AtProvider.NextRunAt.After(cr.Status.AtProvider.LastRunAt.Time)) {
+				t.Errorf("r: want the next run scheduled after the last run, got %v", cr.Status.AtProvider)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+	docompute "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute"
+)
+
+const (
+	// Error strings.
+	errNotVolume = "managed resource is not a Volume resource"
+	errGetVolume = "cannot get volume"
+
+	errVolumeCreateFailed = "creation of Volume resource has failed"
+	errVolumeDeleteFailed = "deletion of Volume resource has failed"
+	errVolumeUpdate       = "cannot update managed Volume resource"
+	errVolumeResize       = "cannot resize Volume"
+	errGetResizeAction    = "cannot get resize action of Volume"
+	errInvalidVolumeSize  = "invalid size of Volume"
+)
+
+// SetupVolume adds a controller that reconciles Volume managed
+// resources.
+func SetupVolume(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.VolumeGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Volume{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VolumeGroupVersionKind),
+			managed.WithExternalConnecter(&volumeConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type volumeConnector struct {
+	kube client.Client
+}
+
+func (c *volumeConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &volumeExternal{Client: client, kube: c.kube}, nil
+}
+
+type volumeExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+func (c *volumeExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Volume)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotVolume)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, response, err := c.Storage.GetVolume(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(do.IgnoreNotFound(err, response), errGetVolume)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	docompute.VolumeLateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errVolumeUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = docompute.GenerateVolumeObservation(*observed)
+
+	// Volumes are usable as soon as they exist.
+	cr.SetConditions(xpv1.Available())
+
+	// Invalid sizes are never up to date so that they are rejected by Update.
+	if err := do.RecordValidation(cr, docompute.ValidateVolumeSize(cr.Spec.ForProvider, cr.Status.AtProvider), errInvalidVolumeSize); err != nil {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	// A Volume reports its previous size until it has been resized.
+	upToDate := cr.Spec.ForProvider.SizeGigabytes == observed.SizeGigaBytes
+	if !upToDate {
+		// The Volume is not resized again while the resize action recorded
+		// by Update is in progress.
+		upToDate, err = docompute.StorageActionInProgress(ctx, c.StorageActions, cr, docompute.AnnotationKeyResizeAction, meta.GetExternalName(cr))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetResizeAction)
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (c *volumeExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Volume)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotVolume)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	create := &godo.VolumeCreateRequest{}
+	docompute.GenerateVolume(cr.GetName(), cr.Spec.ForProvider, create)
+
+	volume, _, err := c.Storage.CreateVolume(ctx, create)
+	if err != nil || volume == nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errVolumeCreateFailed)
+	}
+
+	meta.SetExternalName(cr, volume.ID)
+
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *volumeExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Volume)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotVolume)
+	}
+
+	if err := do.RecordValidation(cr, docompute.ValidateVolumeSize(cr.Spec.ForProvider, cr.Status.AtProvider), errInvalidVolumeSize); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Volumes are resized while they stay attached to their Droplets.
+	action, _, err := c.StorageActions.Resize(ctx, meta.GetExternalName(cr), int(cr.Spec.ForProvider.SizeGigabytes), cr.Status.AtProvider.Region)
+	if err != nil || action == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errVolumeResize)
+	}
+
+	// Only the status is persisted after Update, so the resize action is
+	// recorded explicitly.
+	meta.AddAnnotations(cr, map[string]string{docompute.AnnotationKeyResizeAction: strconv.Itoa(action.ID)})
+	return managed.ExternalUpdate{}, errors.Wrap(c.kube.Update(ctx, cr), errVolumeUpdate)
+}
+
+func (c *volumeExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Volume)
+	if !ok {
+		return errors.New(errNotVolume)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	response, err := c.Storage.DeleteVolume(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(do.IgnoreNotFound(err, response), errVolumeDeleteFailed)
+}
@@ -0,0 +1,425 @@
+package compute
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	dov1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/v1alpha1"
+	docompute "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute/fake"
+)
+
+const (
+	volumeName = "volume"
+	volumeID   = "506f78a4-e098-11e5-ad9f-000f53306ae1"
+	region     = "nyc3"
+)
+
+var errBoom = errors.New("boom")
+
+func notFound() *godo.Response {
+	return &godo.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+}
+
+type volumeModifier func(*v1alpha1.Volume)
+
+func withVolumeExternalName(n string) volumeModifier {
+	return func(cr *v1alpha1.Volume) { meta.SetExternalName(cr, n) }
+}
+
+func withVolumeSpec(p v1alpha1.VolumeParameters) volumeModifier {
+	return func(cr *v1alpha1.Volume) { cr.Spec.ForProvider = p }
+}
+
+func withVolumeStatus(o v1alpha1.VolumeObservation) volumeModifier {
+	return func(cr *v1alpha1.Volume) { cr.Status.AtProvider = o }
+}
+
+func withResizeAction(id string) volumeModifier {
+	return func(cr *v1alpha1.Volume) {
+		meta.AddAnnotations(cr, map[string]string{docompute.AnnotationKeyResizeAction: id})
+	}
+}
+
+func withVolumeConditions(c ...xpv1.Condition) volumeModifier {
+	return func(cr *v1alpha1.Volume) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func volume(m ...volumeModifier) *v1alpha1.Volume {
+	cr := &v1alpha1.Volume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: volumeName,
+		},
+	}
+	meta.SetExternalName(cr, volumeID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func Test_volumeExternal_Observe(t *testing.T) {
+	observed := &godo.Volume{ID: volumeID, Name: volumeName, Region: &godo.Region{Slug: region}, SizeGigaBytes: 10}
+	spec := v1alpha1.VolumeParameters{
+		Region:          region,
+		SizeGigabytes:   10,
+		Description:     godo.String(""),
+		FilesystemType:  godo.String(""),
+		FilesystemLabel: godo.String(""),
+	}
+	larger := spec
+	larger.SizeGigabytes = 20
+	smaller := spec
+	smaller.SizeGigabytes = 5
+	shrinkErr := docompute.ValidateVolumeSize(smaller, docompute.GenerateVolumeObservation(*observed))
+
+	type args struct {
+		cr        *v1alpha1.Volume
+		getErr    error
+		getResp   *godo.Response
+		action    *godo.Action
+		actionErr error
+	}
+	type want struct {
+		cr     *v1alpha1.Volume
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"NotCreated": {
+			args: args{
+				cr: volume(withVolumeExternalName(""), withVolumeSpec(spec)),
+			},
+			want: want{
+				cr: volume(withVolumeExternalName(""), withVolumeSpec(spec)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cr:      volume(withVolumeSpec(spec)),
+				getErr:  errBoom,
+				getResp: notFound(),
+			},
+			want: want{
+				cr: volume(withVolumeSpec(spec)),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				cr:     volume(withVolumeSpec(spec)),
+				getErr: errBoom,
+			},
+			want: want{
+				cr:  volume(withVolumeSpec(spec)),
+				err: errors.Wrap(errBoom, errGetVolume),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				cr: volume(withVolumeSpec(spec)),
+			},
+			want: want{
+				cr: volume(withVolumeSpec(spec), withVolumeStatus(docompute.GenerateVolumeObservation(*observed)),
+					withVolumeConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Grown": {
+			args: args{
+				cr: volume(withVolumeSpec(larger)),
+			},
+			want: want{
+				cr: volume(withVolumeSpec(larger), withVolumeStatus(docompute.GenerateVolumeObservation(*observed)),
+					withVolumeConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Resizing": {
+			args: args{
+				cr:     volume(withVolumeSpec(larger), withResizeAction("42")),
+				action: &godo.Action{ID: 42, Status: godo.ActionInProgress},
+			},
+			want: want{
+				cr: volume(withVolumeSpec(larger), withResizeAction("42"), withVolumeStatus(docompute.GenerateVolumeObservation(*observed)),
+					withVolumeConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ResizeFinished": {
+			args: args{
+				cr:     volume(withVolumeSpec(larger), withResizeAction("42")),
+				action: &godo.Action{ID: 42, Status: godo.ActionCompleted},
+			},
+			want: want{
+				cr: volume(withVolumeSpec(larger), withResizeAction("42"), withVolumeStatus(docompute.GenerateVolumeObservation(*observed)),
+					withVolumeConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"GetResizeActionFailed": {
+			args: args{
+				cr:        volume(withVolumeSpec(larger), withResizeAction("42")),
+				actionErr: errBoom,
+			},
+			want: want{
+				cr: volume(withVolumeSpec(larger), withResizeAction("42"), withVolumeStatus(docompute.GenerateVolumeObservation(*observed)),
+					withVolumeConditions(xpv1.Available())),
+				err: errors.Wrap(errBoom, errGetResizeAction),
+			},
+		},
+		"ShrinkStillRejected": {
+			args: args{
+				cr: volume(withVolumeSpec(smaller), withVolumeConditions(dov1alpha1.ParametersRejected(shrinkErr))),
+			},
+			want: want{
+				cr: volume(withVolumeSpec(smaller), withVolumeStatus(docompute.GenerateVolumeObservation(*observed)),
+					withVolumeConditions(xpv1.Available(), dov1alpha1.ParametersRejected(shrinkErr))),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ShrinkCorrected": {
+			args: args{
+				cr: volume(withVolumeSpec(spec), withVolumeConditions(dov1alpha1.ParametersRejected(shrinkErr))),
+			},
+			want: want{
+				cr: volume(withVolumeSpec(spec), withVolumeStatus(docompute.GenerateVolumeObservation(*observed)),
+					withVolumeConditions(xpv1.Available(), dov1alpha1.ParametersAccepted())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &volumeExternal{Client: &godo.Client{
+				Storage: &fake.MockStorageService{
+					MockGetVolume: func(_ context.Context, id string) (*godo.Volume, *godo.Response, error) {
+						if id != volumeID {
+							t.Errorf("unexpected volume %s observed", id)
+						}
+						if tc.args.getErr != nil {
+							return nil, tc.args.getResp, tc.args.getErr
+						}
+						return observed, nil, nil
+					},
+				},
+				StorageActions: &fake.MockStorageActionsService{
+					MockGet: func(_ context.Context, id string, actionID int) (*godo.Action, *godo.Response, error) {
+						if id != volumeID || actionID != 42 {
+							t.Errorf("unexpected action %d of volume %s observed", actionID, id)
+						}
+						return tc.args.action, nil, tc.args.actionErr
+					},
+				},
+			}}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_volumeExternal_Create(t *testing.T) {
+	spec := v1alpha1.VolumeParameters{Region: region, SizeGigabytes: 10}
+
+	type want struct {
+		cr     *v1alpha1.Volume
+		result managed.ExternalCreation
+		err    error
+	}
+	tests := map[string]struct {
+		createErr error
+		want      want
+	}{
+		"Created": {
+			want: want{
+				cr:     volume(withVolumeSpec(spec), withVolumeConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			createErr: errBoom,
+			want: want{
+				cr:  volume(withVolumeExternalName(""), withVolumeSpec(spec), withVolumeConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errVolumeCreateFailed),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &volumeExternal{Client: &godo.Client{Storage: &fake.MockStorageService{
+				MockCreateVolume: func(_ context.Context, create *godo.VolumeCreateRequest) (*godo.Volume, *godo.Response, error) {
+					if create.Name != volumeName || create.SizeGigaBytes != 10 {
+						t.Errorf("unexpected volume %s of %d GiB created", create.Name, create.SizeGigaBytes)
+					}
+					if tc.createErr != nil {
+						return nil, nil, tc.createErr
+					}
+					return &godo.Volume{ID: volumeID}, nil, nil
+				},
+			}}}
+			cr := volume(withVolumeExternalName(""), withVolumeSpec(spec))
+			c, err := e.Create(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, c); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_volumeExternal_Update(t *testing.T) {
+	observed := v1alpha1.VolumeObservation{ID: volumeID, Region: region, SizeGigabytes: 10}
+	larger := v1alpha1.VolumeParameters{Region: region, SizeGigabytes: 20}
+	smaller := v1alpha1.VolumeParameters{Region: region, SizeGigabytes: 5}
+	shrinkErr := docompute.ValidateVolumeSize(smaller, observed)
+
+	type resize struct {
+		size   int
+		region string
+	}
+	type want struct {
+		cr     *v1alpha1.Volume
+		resize *resize
+		err    error
+	}
+	tests := map[string]struct {
+		cr        *v1alpha1.Volume
+		resizeErr error
+		updateErr error
+		want      want
+	}{
+		"Resized": {
+			cr: volume(withVolumeSpec(larger), withVolumeStatus(observed)),
+			want: want{
+				cr:     volume(withVolumeSpec(larger), withVolumeStatus(observed), withResizeAction("42")),
+				resize: &resize{size: 20, region: region},
+			},
+		},
+		"RecordResizeActionFailed": {
+			cr:        volume(withVolumeSpec(larger), withVolumeStatus(observed)),
+			updateErr: errBoom,
+			want: want{
+				cr:     volume(withVolumeSpec(larger), withVolumeStatus(observed), withResizeAction("42")),
+				resize: &resize{size: 20, region: region},
+				err:    errors.Wrap(errBoom, errVolumeUpdate),
+			},
+		},
+		"ResizeFailed": {
+			cr:        volume(withVolumeSpec(larger), withVolumeStatus(observed)),
+			resizeErr: errBoom,
+			want: want{
+				cr:     volume(withVolumeSpec(larger), withVolumeStatus(observed)),
+				resize: &resize{size: 20, region: region},
+				err:    errors.Wrap(errBoom, errVolumeResize),
+			},
+		},
+		"ShrinkRejected": {
+			cr: volume(withVolumeSpec(smaller), withVolumeStatus(observed)),
+			want: want{
+				cr:  volume(withVolumeSpec(smaller), withVolumeStatus(observed), withVolumeConditions(dov1alpha1.ParametersRejected(shrinkErr))),
+				err: errors.Wrap(shrinkErr, errInvalidVolumeSize),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got *resize
+			e := &volumeExternal{
+				kube: &test.MockClient{
+					MockUpdate: func(context.Context, client.Object, ...client.UpdateOption) error {
+						return tc.updateErr
+					},
+				},
+				Client: &godo.Client{StorageActions: &fake.MockStorageActionsService{
+					MockResize: func(_ context.Context, _ string, size int, region string) (*godo.Action, *godo.Response, error) {
+						got = &resize{size: size, region: region}
+						if tc.resizeErr != nil {
+							return nil, nil, tc.resizeErr
+						}
+						return &godo.Action{ID: 42, Status: godo.ActionInProgress}, nil, nil
+					},
+				}},
+			}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.resize, got, cmp.AllowUnexported(resize{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_volumeExternal_Delete(t *testing.T) {
+	type args struct {
+		deleteErr  error
+		deleteResp *godo.Response
+	}
+	tests := map[string]struct {
+		args args
+		want error
+	}{
+		"Deleted": {
+			args: args{},
+		},
+		"AlreadyGone": {
+			args: args{deleteErr: errBoom, deleteResp: notFound()},
+		},
+		"DeleteFailed": {
+			args: args{deleteErr: errBoom},
+			want: errors.Wrap(errBoom, errVolumeDeleteFailed),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &volumeExternal{Client: &godo.Client{Storage: &fake.MockStorageService{
+				MockDeleteVolume: func(_ context.Context, id string) (*godo.Response, error) {
+					if id != volumeID {
+						t.Errorf("unexpected volume %s deleted", id)
+					}
+					return tc.args.deleteResp, tc.args.deleteErr
+				},
+			}}}
+			err := e.Delete(context.Background(), volume())
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
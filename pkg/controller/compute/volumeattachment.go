@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+	docompute "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute"
+)
+
+const (
+	// Error strings.
+	errNotVolumeAttachment = "managed resource is not a VolumeAttachment resource"
+	errGetAttachedVolume   = "cannot get attached volume"
+	errGetAttachmentAction = "cannot get attachment action of Volume"
+	errUpdateAttachment    = "cannot update managed VolumeAttachment resource"
+
+	errVolumeAttachFailed = "attachment of Volume has failed"
+	errVolumeDetachFailed = "detachment of Volume has failed"
+)
+
+// SetupVolumeAttachment adds a controller that reconciles VolumeAttachment
+// managed resources.
+func SetupVolumeAttachment(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.VolumeAttachmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.VolumeAttachment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VolumeAttachmentGroupVersionKind),
+			managed.WithExternalConnecter(&volumeAttachmentConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type volumeAttachmentConnector struct {
+	kube client.Client
+}
+
+func (c *volumeAttachmentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &volumeAttachmentExternal{Client: client, kube: c.kube}, nil
+}
+
+type volumeAttachmentExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+func (c *volumeAttachmentExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.VolumeAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotVolumeAttachment)
+	}
+
+	dropletID, err := docompute.ParseDropletID(cr.Spec.ForProvider.DropletID)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	volume, response, err := c.Storage.GetVolume(ctx, do.StringValue(cr.Spec.ForProvider.VolumeID))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(do.IgnoreNotFound(err, response), errGetAttachedVolume)
+	}
+
+	cr.Status.AtProvider = v1alpha1.VolumeAttachmentObservation{
+		VolumeName: volume.Name,
+		DropletIDs: volume.DropletIDs,
+	}
+
+	if !docompute.IsAttached(*volume, dropletID) {
+		// A Volume is only listed as attached once the attach action recorded
+		// by Create completed.
+		attaching, err := docompute.StorageActionInProgress(ctx, c.StorageActions, cr, docompute.AnnotationKeyAttachmentAction, do.StringValue(cr.Spec.ForProvider.VolumeID))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetAttachmentAction)
+		}
+		if attaching {
+			cr.SetConditions(xpv1.Creating())
+		}
+		return managed.ExternalObservation{
+			ResourceExists:   attaching,
+			ResourceUpToDate: attaching,
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (c *volumeAttachmentExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.VolumeAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotVolumeAttachment)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	dropletID, err := docompute.ParseDropletID(cr.Spec.ForProvider.DropletID)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	action, _, err := c.StorageActions.Attach(ctx, do.StringValue(cr.Spec.ForProvider.VolumeID), dropletID)
+	if err != nil || action == nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errVolumeAttachFailed)
+	}
+
+	meta.AddAnnotations(cr, map[string]string{docompute.AnnotationKeyAttachmentAction: strconv.Itoa(action.ID)})
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *volumeAttachmentExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// VolumeAttachments cannot be updated.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *volumeAttachmentExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.VolumeAttachment)
+	if !ok {
+		return errors.New(errNotVolumeAttachment)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	dropletID, err := docompute.ParseDropletID(cr.Spec.ForProvider.DropletID)
+	if err != nil {
+		return err
+	}
+
+	// The Volume is not detached again while the detach action recorded by a
+	// previous Delete, or the attach action recorded by Create, is in progress.
+	volumeID := do.StringValue(cr.Spec.ForProvider.VolumeID)
+	pending, err := docompute.StorageActionInProgress(ctx, c.StorageActions, cr, docompute.AnnotationKeyAttachmentAction, volumeID)
+	if err != nil {
+		return errors.Wrap(err, errGetAttachmentAction)
+	}
+	if pending {
+		return nil
+	}
+
+	action, response, err := c.StorageActions.DetachByDropletID(ctx, volumeID, dropletID)
+	if err != nil || action == nil {
+		return errors.Wrap(do.IgnoreNotFound(err, response), errVolumeDetachFailed)
+	}
+
+	// Only the status is persisted after Delete, so the detach action is
+	// recorded explicitly.
+	meta.AddAnnotations(cr, map[string]string{docompute.AnnotationKeyAttachmentAction: strconv.Itoa(action.ID)})
+	return errors.Wrap(c.kube.Update(ctx, cr), errUpdateAttachment)
+}
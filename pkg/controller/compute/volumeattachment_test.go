@@ -0,0 +1,353 @@
+package compute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	docompute "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute/fake"
+)
+
+const dropletID = 123
+
+type attachmentModifier func(*v1alpha1.VolumeAttachment)
+
+func withAttachmentDropletID(id string) attachmentModifier {
+	return func(cr *v1alpha1.VolumeAttachment) { cr.Spec.ForProvider.DropletID = &id }
+}
+
+func withAttachmentAction(id string) attachmentModifier {
+	return func(cr *v1alpha1.VolumeAttachment) {
+		meta.AddAnnotations(cr, map[string]string{docompute.AnnotationKeyAttachmentAction: id})
+	}
+}
+
+func withAttachmentStatus(o v1alpha1.VolumeAttachmentObservation) attachmentModifier {
+	return func(cr *v1alpha1.VolumeAttachment) { cr.Status.AtProvider = o }
+}
+
+func withAttachmentConditions(c ...xpv1.Condition) attachmentModifier {
+	return func(cr *v1alpha1.VolumeAttachment) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func volumeAttachment(m ...attachmentModifier) *v1alpha1.VolumeAttachment {
+	cr := &v1alpha1.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "attachment",
+		},
+		Spec: v1alpha1.VolumeAttachmentSpec{
+			ForProvider: v1alpha1.VolumeAttachmentParameters{
+				VolumeID:  godo.String(volumeID),
+				DropletID: godo.String("123"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func Test_volumeAttachmentExternal_Observe(t *testing.T) {
+	_, invalidErr := docompute.ParseDropletID(godo.String("droplet"))
+
+	type args struct {
+		cr        *v1alpha1.VolumeAttachment
+		volume    *godo.Volume
+		getErr    error
+		getResp   *godo.Response
+		action    *godo.Action
+		actionErr error
+	}
+	type want struct {
+		cr     *v1alpha1.VolumeAttachment
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"InvalidDropletID": {
+			args: args{
+				cr: volumeAttachment(withAttachmentDropletID("droplet")),
+			},
+			want: want{
+				cr:  volumeAttachment(withAttachmentDropletID("droplet")),
+				err: invalidErr,
+			},
+		},
+		"VolumeNotFound": {
+			args: args{
+				cr:      volumeAttachment(),
+				getErr:  errBoom,
+				getResp: notFound(),
+			},
+			want: want{
+				cr: volumeAttachment(),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				cr:     volumeAttachment(),
+				getErr: errBoom,
+			},
+			want: want{
+				cr:  volumeAttachment(),
+				err: errors.Wrap(errBoom, errGetAttachedVolume),
+			},
+		},
+		"NotAttached": {
+			args: args{
+				cr:     volumeAttachment(),
+				volume: &godo.Volume{Name: volumeName, DropletIDs: []int{456}},
+			},
+			want: want{
+				cr: volumeAttachment(withAttachmentStatus(v1alpha1.VolumeAttachmentObservation{VolumeName: volumeName, DropletIDs: []int{456}})),
+			},
+		},
+		"Attaching": {
+			args: args{
+				cr:     volumeAttachment(withAttachmentAction("1")),
+				volume: &godo.Volume{Name: volumeName},
+				action: &godo.Action{ID: 1, Status: godo.ActionInProgress},
+			},
+			want: want{
+				cr: volumeAttachment(withAttachmentAction("1"), withAttachmentStatus(v1alpha1.VolumeAttachmentObservation{VolumeName: volumeName}),
+					withAttachmentConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"AttachErrored": {
+			args: args{
+				cr:     volumeAttachment(withAttachmentAction("1")),
+				volume: &godo.Volume{Name: volumeName},
+				action: &godo.Action{ID: 1, Status: "errored"},
+			},
+			want: want{
+				cr: volumeAttachment(withAttachmentAction("1"), withAttachmentStatus(v1alpha1.VolumeAttachmentObservation{VolumeName: volumeName})),
+			},
+		},
+		"GetActionFailed": {
+			args: args{
+				cr:        volumeAttachment(withAttachmentAction("1")),
+				volume:    &godo.Volume{Name: volumeName},
+				actionErr: errBoom,
+			},
+			want: want{
+				cr:  volumeAttachment(withAttachmentAction("1"), withAttachmentStatus(v1alpha1.VolumeAttachmentObservation{VolumeName: volumeName})),
+				err: errors.Wrap(errBoom, errGetAttachmentAction),
+			},
+		},
+		"Attached": {
+			args: args{
+				cr:     volumeAttachment(),
+				volume: &godo.Volume{Name: volumeName, DropletIDs: []int{dropletID}},
+			},
+			want: want{
+				cr: volumeAttachment(withAttachmentStatus(v1alpha1.VolumeAttachmentObservation{VolumeName: volumeName, DropletIDs: []int{dropletID}}),
+					withAttachmentConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &volumeAttachmentExternal{Client: &godo.Client{
+				Storage: &fake.MockStorageService{
+					MockGetVolume: func(context.Context, string) (*godo.Volume, *godo.Response, error) {
+						return tc.args.volume, tc.args.getResp, tc.args.getErr
+					},
+				},
+				StorageActions: &fake.MockStorageActionsService{
+					MockGet: func(_ context.Context, volume string, id int) (*godo.Action, *godo.Response, error) {
+						if volume != volumeID || id != 1 {
+							t.Errorf("unexpected action %d of volume %s observed", id, volume)
+						}
+						return tc.args.action, nil, tc.args.actionErr
+					},
+				},
+			}}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_volumeAttachmentExternal_Create(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.VolumeAttachment
+		err error
+	}
+	tests := map[string]struct {
+		action    *godo.Action
+		attachErr error
+		want      want
+	}{
+		"Attaching": {
+			action: &godo.Action{ID: 1, Status: godo.ActionInProgress},
+			want: want{
+				cr: volumeAttachment(withAttachmentAction("1"), withAttachmentConditions(xpv1.Creating())),
+			},
+		},
+		"AttachFailed": {
+			attachErr: errBoom,
+			want: want{
+				cr:  volumeAttachment(withAttachmentConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errVolumeAttachFailed),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &volumeAttachmentExternal{Client: &godo.Client{StorageActions: &fake.MockStorageActionsService{
+				MockAttach: func(_ context.Context, volume string, droplet int) (*godo.Action, *godo.Response, error) {
+					if volume != volumeID || droplet != dropletID {
+						t.Errorf("unexpected volume %s attached to droplet %d", volume, droplet)
+					}
+					return tc.action, nil, tc.attachErr
+				},
+			}}}
+			cr := volumeAttachment()
+			_, err := e.Create(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_volumeAttachmentExternal_Delete(t *testing.T) {
+	type args struct {
+		cr         *v1alpha1.VolumeAttachment
+		pending    *godo.Action
+		action     *godo.Action
+		detachErr  error
+		detachResp *godo.Response
+		updateErr  error
+	}
+	type want struct {
+		cr       *v1alpha1.VolumeAttachment
+		detached bool
+		err      error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"Detaching": {
+			args: args{
+				cr:      volumeAttachment(withAttachmentAction("1")),
+				pending: &godo.Action{ID: 1, Status: godo.ActionCompleted},
+				action:  &godo.Action{ID: 2, Status: godo.ActionInProgress},
+			},
+			want: want{
+				cr:       volumeAttachment(withAttachmentAction("2"), withAttachmentConditions(xpv1.Deleting())),
+				detached: true,
+			},
+		},
+		"ActionPending": {
+			args: args{
+				cr:      volumeAttachment(withAttachmentAction("1")),
+				pending: &godo.Action{ID: 1, Status: godo.ActionInProgress},
+			},
+			want: want{
+				cr: volumeAttachment(withAttachmentAction("1"), withAttachmentConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				cr:         volumeAttachment(),
+				detachErr:  errBoom,
+				detachResp: notFound(),
+			},
+			want: want{
+				cr:       volumeAttachment(withAttachmentConditions(xpv1.Deleting())),
+				detached: true,
+			},
+		},
+		"DetachFailed": {
+			args: args{
+				cr:        volumeAttachment(),
+				detachErr: errBoom,
+			},
+			want: want{
+				cr:       volumeAttachment(withAttachmentConditions(xpv1.Deleting())),
+				detached: true,
+				err:      errors.Wrap(errBoom, errVolumeDetachFailed),
+			},
+		},
+		"RecordActionFailed": {
+			args: args{
+				cr:        volumeAttachment(),
+				action:    &godo.Action{ID: 2, Status: godo.ActionInProgress},
+				updateErr: errBoom,
+			},
+			want: want{
+				cr:       volumeAttachment(withAttachmentAction("2"), withAttachmentConditions(xpv1.Deleting())),
+				detached: true,
+				err:      errors.Wrap(errBoom, errUpdateAttachment),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			detached := false
+			e := &volumeAttachmentExternal{
+				kube: &test.MockClient{
+					MockUpdate: func(context.Context, client.Object, ...client.UpdateOption) error {
+						return tc.args.updateErr
+					},
+				},
+				Client: &godo.Client{StorageActions: &fake.MockStorageActionsService{
+					MockGet: func(context.Context, string, int) (*godo.Action, *godo.Response, error) {
+						return tc.args.pending, nil, nil
+					},
+					MockDetachByDropletID: func(_ context.Context, volume string, droplet int) (*godo.Action, *godo.Response, error) {
+						if volume != volumeID || droplet != dropletID {
+							t.Errorf("unexpected volume %s detached from droplet %d", volume, droplet)
+						}
+						detached = true
+						return tc.args.action, tc.args.detachResp, tc.args.detachErr
+					},
+				}},
+			}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.detached, detached); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/compute/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+	docompute "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/compute"
+)
+
+const (
+	// Error strings.
+	errNotVolumeSnapshot = "managed resource is not a VolumeSnapshot resource"
+
+	errVolumeSnapshotCreateFailed = "creation of VolumeSnapshot resource has failed"
+)
+
+// SetupVolumeSnapshot adds a controller that reconciles VolumeSnapshot
+// managed resources.
+func SetupVolumeSnapshot(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.VolumeSnapshotGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.VolumeSnapshot{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VolumeSnapshotGroupVersionKind),
+			managed.WithExternalConnecter(&volumeSnapshotConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type volumeSnapshotConnector struct {
+	kube client.Client
+}
+
+func (c *volumeSnapshotConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &volumeSnapshotExternal{Client: client, kube: c.kube}, nil
+}
+
+type volumeSnapshotExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+func (c *volumeSnapshotExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.VolumeSnapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotVolumeSnapshot)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, response, err := c.Snapshots.Get(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(do.IgnoreNotFound(err, response), errGetSnapshot)
+	}
+
+	cr.Status.AtProvider = docompute.GenerateSnapshotObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	// Snapshots are always "up to date" because they can't be updated.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (c *volumeSnapshotExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.VolumeSnapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotVolumeSnapshot)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	// Volume snapshots are taken synchronously, so they are complete once
+	// they were created.
+	snapshot, _, err := c.Storage.CreateSnapshot(ctx, docompute.GenerateVolumeSnapshot(cr.GetName(), cr.Spec.ForProvider))
+	if err != nil || snapshot == nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errVolumeSnapshotCreateFailed)
+	}
+
+	meta.SetExternalName(cr, snapshot.ID)
+
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *volumeSnapshotExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// Snapshots cannot be updated.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *volumeSnapshotExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.VolumeSnapshot)
+	if !ok {
+		return errors.New(errNotVolumeSnapshot)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	response, err := c.Snapshots.Delete(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(do.IgnoreNotFound(err, response), errSnapshotDeleteFailed)
+}
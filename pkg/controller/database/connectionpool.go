@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+	dodb "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/database"
+)
+
+const (
+	// Error strings.
+	errNotPool            = "managed resource is not a Connection Pool resource"
+	errPoolNoCluster      = "Database Cluster of Connection Pool is not set"
+	errGetPool            = "cannot get a Connection Pool"
+	errGetPoolCluster     = "cannot get the Database Cluster of a Connection Pool"
+	errInvalidPool        = "invalid Connection Pool parameters"
+	errPoolCreateFailed   = "creation of Connection Pool resource has failed"
+	errPoolDeleteFailed   = "deletion of Connection Pool resource has failed"
+	errPoolUpdateFailed   = "update of Connection Pool resource has failed"
+	errPoolLateInitialize = "cannot update managed Connection Pool resource"
+)
+
+// SetupConnectionPool adds a controller that reconciles DODatabaseConnectionPool
+// managed resources.
+func SetupConnectionPool(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DODatabaseConnectionPoolGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DODatabaseConnectionPool{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DODatabaseConnectionPoolGroupVersionKind),
+			managed.WithExternalConnecter(&poolConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type poolConnector struct {
+	kube client.Client
+}
+
+func (c *poolConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &poolExternal{Client: client, kube: c.kube}, nil
+}
+
+type poolExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+func (c *poolExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DODatabaseConnectionPool)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPool)
+	}
+
+	clusterID := do.StringValue(cr.Spec.ForProvider.ClusterID)
+	if clusterID == "" {
+		return managed.ExternalObservation{}, errors.New(errPoolNoCluster)
+	}
+
+	observed, response, err := c.Databases.GetPool(ctx, clusterID, meta.GetExternalName(cr))
+	if err != nil {
+		if err := do.IgnoreNotFound(err, response); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetPool)
+		}
+		if err := c.validateCluster(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	dodb.PoolLateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errPoolLateInitialize)
+		}
+	}
+
+	cr.Status.AtProvider = dodb.GeneratePoolObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  dodb.PoolIsUpToDate(cr.Spec.ForProvider, *observed),
+		ConnectionDetails: dodb.GenerateConnectionDetails(observed.Connection, observed.PrivateConnection),
+	}, nil
+}
+
+// validateCluster validates that the Database Cluster of the supplied
+// Connection Pool supports connection pools and records the outcome in the
+// conditions of the pool.
+func (c *poolExternal) validateCluster(ctx context.Context, cr *v1alpha1.DODatabaseConnectionPool) error {
+	cluster, _, err := c.Databases.Get(ctx, do.StringValue(cr.Spec.ForProvider.ClusterID))
+	if err != nil {
+		return errors.Wrap(err, errGetPoolCluster)
+	}
+	return do.RecordValidation(cr, dodb.ValidatePoolEngine(cluster.EngineSlug), errInvalidPool)
+}
+
+func (c *poolExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DODatabaseConnectionPool)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPool)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	if err := c.validateCluster(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	pool, _, err := c.Databases.CreatePool(ctx, do.StringValue(cr.Spec.ForProvider.ClusterID), dodb.GenerateCreatePoolRequest(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	if err != nil || pool == nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPoolCreateFailed)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: dodb.GenerateConnectionDetails(pool.Connection, pool.PrivateConnection),
+	}, nil
+}
+
+func (c *poolExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DODatabaseConnectionPool)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPool)
+	}
+
+	_, err := dodb.UpdatePool(ctx, c.Client, do.StringValue(cr.Spec.ForProvider.ClusterID), meta.GetExternalName(cr), dodb.GenerateUpdatePoolRequest(cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errPoolUpdateFailed)
+}
+
+func (c *poolExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DODatabaseConnectionPool)
+	if !ok {
+		return errors.New(errNotPool)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	response, err := c.Databases.DeletePool(ctx, do.StringValue(cr.Spec.ForProvider.ClusterID), meta.GetExternalName(cr))
+	return errors.Wrap(do.IgnoreNotFound(err, response), errPoolDeleteFailed)
+}
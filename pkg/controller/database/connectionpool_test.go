@@ -0,0 +1,281 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	dov1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/v1alpha1"
+	dodb "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/database"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/database/fake"
+)
+
+const poolName = "pool"
+
+type poolModifier func(*v1alpha1.DODatabaseConnectionPool)
+
+func withPoolSpec(p v1alpha1.DODatabaseConnectionPoolParameters) poolModifier {
+	return func(cr *v1alpha1.DODatabaseConnectionPool) { cr.Spec.ForProvider = p }
+}
+
+func withPoolStatus(o v1alpha1.DODatabaseConnectionPoolObservation) poolModifier {
+	return func(cr *v1alpha1.DODatabaseConnectionPool) { cr.Status.AtProvider = o }
+}
+
+func withPoolConditions(c ...xpv1.Condition) poolModifier {
+	return func(cr *v1alpha1.DODatabaseConnectionPool) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func connectionPool(m ...poolModifier) *v1alpha1.DODatabaseConnectionPool {
+	cr := &v1alpha1.DODatabaseConnectionPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: poolName,
+		},
+	}
+	meta.SetExternalName(cr, poolName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func Test_poolExternal_Observe(t *testing.T) {
+	spec := v1alpha1.DODatabaseConnectionPoolParameters{
+		ClusterID: godo.String(clusterID),
+		Mode:      "transaction",
+		Size:      10,
+		Database:  godo.String("defaultdb"),
+		User:      godo.String("doadmin"),
+	}
+	resized := spec
+	resized.Size = 20
+	pool := &godo.DatabasePool{Name: poolName, Mode: "transaction", Size: 10, Database: "defaultdb", User: "doadmin"}
+	rejected := dodb.ValidatePoolEngine(v1alpha1.EngineMySQL)
+
+	type args struct {
+		cr      *v1alpha1.DODatabaseConnectionPool
+		engine  string
+		getErr  error
+		getResp *godo.Response
+	}
+	type want struct {
+		cr     *v1alpha1.DODatabaseConnectionPool
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"NoCluster": {
+			args: args{
+				cr: connectionPool(),
+			},
+			want: want{
+				cr:  connectionPool(),
+				err: errors.New(errPoolNoCluster),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				cr:     connectionPool(withPoolSpec(spec)),
+				getErr: errBoom,
+			},
+			want: want{
+				cr:  connectionPool(withPoolSpec(spec)),
+				err: errors.Wrap(errBoom, errGetPool),
+			},
+		},
+		"NotCreated": {
+			args: args{
+				cr:      connectionPool(withPoolSpec(spec)),
+				engine:  v1alpha1.EnginePostgres,
+				getErr:  errBoom,
+				getResp: notFound(),
+			},
+			want: want{
+				cr: connectionPool(withPoolSpec(spec)),
+			},
+		},
+		"NotCreatedEngineRejected": {
+			args: args{
+				cr:      connectionPool(withPoolSpec(spec)),
+				engine:  v1alpha1.EngineMySQL,
+				getErr:  errBoom,
+				getResp: notFound(),
+			},
+			want: want{
+				cr:  connectionPool(withPoolSpec(spec), withPoolConditions(dov1alpha1.ParametersRejected(rejected))),
+				err: errors.Wrap(rejected, errInvalidPool),
+			},
+		},
+		"NotCreatedEngineCorrected": {
+			args: args{
+				cr:      connectionPool(withPoolSpec(spec), withPoolConditions(dov1alpha1.ParametersRejected(rejected))),
+				engine:  v1alpha1.EnginePostgres,
+				getErr:  errBoom,
+				getResp: notFound(),
+			},
+			want: want{
+				cr: connectionPool(withPoolSpec(spec), withPoolConditions(dov1alpha1.ParametersAccepted())),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				cr: connectionPool(withPoolSpec(spec)),
+			},
+			want: want{
+				cr: connectionPool(withPoolSpec(spec), withPoolStatus(dodb.GeneratePoolObservation(pool)),
+					withPoolConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: dodb.GenerateConnectionDetails(nil, nil),
+				},
+			},
+		},
+		"Resized": {
+			args: args{
+				cr: connectionPool(withPoolSpec(resized)),
+			},
+			want: want{
+				cr: connectionPool(withPoolSpec(resized), withPoolStatus(dodb.GeneratePoolObservation(pool)),
+					withPoolConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: dodb.GenerateConnectionDetails(nil, nil),
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &poolExternal{Client: &godo.Client{Databases: &fake.MockDatabasesService{
+				MockGetPool: func(context.Context, string, string) (*godo.DatabasePool, *godo.Response, error) {
+					if tc.args.getErr != nil {
+						return nil, tc.args.getResp, tc.args.getErr
+					}
+					return pool, nil, nil
+				},
+				MockGet: func(context.Context, string) (*godo.Database, *godo.Response, error) {
+					return &godo.Database{ID: clusterID, EngineSlug: tc.args.engine}, nil, nil
+				},
+			}}}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_poolExternal_Create(t *testing.T) {
+	spec := v1alpha1.DODatabaseConnectionPoolParameters{
+		ClusterID: godo.String(clusterID),
+		Mode:      "transaction",
+		Size:      10,
+		Database:  godo.String("defaultdb"),
+	}
+	rejected := dodb.ValidatePoolEngine(v1alpha1.EngineRedis)
+
+	type want struct {
+		create *godo.DatabaseCreatePoolRequest
+		err    error
+	}
+	tests := map[string]struct {
+		engine string
+		want   want
+	}{
+		"Created": {
+			engine: v1alpha1.EnginePostgres,
+			want: want{
+				create: &godo.DatabaseCreatePoolRequest{Name: poolName, Mode: "transaction", Size: 10, Database: "defaultdb"},
+			},
+		},
+		"EngineRejected": {
+			engine: v1alpha1.EngineRedis,
+			want: want{
+				err: errors.Wrap(rejected, errInvalidPool),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var create *godo.DatabaseCreatePoolRequest
+			e := &poolExternal{Client: &godo.Client{Databases: &fake.MockDatabasesService{
+				MockGet: func(context.Context, string) (*godo.Database, *godo.Response, error) {
+					return &godo.Database{ID: clusterID, EngineSlug: tc.engine}, nil, nil
+				},
+				MockCreatePool: func(_ context.Context, _ string, r *godo.DatabaseCreatePoolRequest) (*godo.DatabasePool, *godo.Response, error) {
+					create = r
+					return &godo.DatabasePool{Name: r.Name}, nil, nil
+				},
+			}}}
+			_, err := e.Create(context.Background(), connectionPool(withPoolSpec(spec)))
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_poolExternal_Delete(t *testing.T) {
+	type args struct {
+		deleteErr  error
+		deleteResp *godo.Response
+	}
+	tests := map[string]struct {
+		args args
+		want error
+	}{
+		"Deleted": {
+			args: args{},
+		},
+		"AlreadyGone": {
+			args: args{deleteErr: errBoom, deleteResp: notFound()},
+		},
+		"DeleteFailed": {
+			args: args{deleteErr: errBoom},
+			want: errors.Wrap(errBoom, errPoolDeleteFailed),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &poolExternal{Client: &godo.Client{Databases: &fake.MockDatabasesService{
+				MockDeletePool: func(_ context.Context, cluster, pool string) (*godo.Response, error) {
+					if cluster != clusterID || pool != poolName {
+						t.Errorf("unexpected pool %s/%s deleted", cluster, pool)
+					}
+					return tc.args.deleteResp, tc.args.deleteErr
+				},
+			}}}
+			err := e.Delete(context.Background(), connectionPool(withPoolSpec(v1alpha1.DODatabaseConnectionPoolParameters{ClusterID: godo.String(clusterID)})))
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
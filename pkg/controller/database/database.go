@@ -15,7 +15,8 @@ package database
 
 import (
 	"context"
-	"strconv"
+	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/google/go-cmp/cmp"
@@ -44,6 +45,26 @@ const (
 	errDBCreateFailed = "creation of Database Cluster resource has failed"
 	errDBDeleteFailed = "deletion of Database Cluster resource has failed"
 	errDBUpdate       = "cannot update managed Database Cluster resource"
+
+	errDBResize            = "cannot resize Database Cluster"
+	errDBMigrate           = "cannot migrate Database Cluster"
+	errDBUpdateMaintenance = "cannot update maintenance window of Database Cluster"
+
+	errGetFirewallRules      = "cannot get trusted sources of Database Cluster"
+	errUpdateFirewallRules   = "cannot update trusted sources of Database Cluster"
+	errInvalidTrustedSources = "invalid trusted sources of Database Cluster"
+
+	errGetCA = "cannot get CA certificate of Database Cluster"
+
+	errListBackups = "cannot list backups of Database Cluster"
+
+	errInvalidEngineSettings = "invalid engine settings of Database Cluster"
+	errGetEvictionPolicy     = "cannot get eviction policy of Database Cluster"
+	errSetEvictionPolicy     = "cannot set eviction policy of Database Cluster"
+	errGetSQLMode            = "cannot get SQL modes of Database Cluster"
+	errSetSQLMode            = "cannot set SQL modes of Database Cluster"
+
+	errInstallUpdate = "cannot install pending updates of Database Cluster"
 )
 
 // SetupDatabase adds a controller that reconciles Database managed
@@ -89,6 +110,9 @@ func (c *dbExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 	}
 
 	if meta.GetExternalName(cr) == "" {
+		if err := validateEngineSettings(cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, nil
@@ -162,26 +186,138 @@ func (c *dbExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 	}
 
 	setCrossplaneStatus(cr)
+	setMaintenanceStatus(cr)
+
+	// A cluster that is being resized or migrated reports its previous size
+	// and region until the operation completes, so it is not updated again.
+	upToDate := dodb.IsUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider) ||
+		observed.Status == v1alpha1.StatusResizing ||
+		observed.Status == v1alpha1.StatusMigrating
+	upToDate = upToDate && !installUpdateDue(cr, time.Now())
+
+	// Trusted sources are only managed when they are specified.
+	if cr.Spec.ForProvider.TrustedSources != nil {
+		rules, _, err := c.Databases.GetFirewallRules(ctx, observed.ID)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetFirewallRules)
+		}
+		cr.Status.AtProvider.TrustedSources = dodb.GenerateFirewallRuleObservations(rules)
+
+		desired, err := dodb.GenerateFirewallRules(cr.Spec.ForProvider.TrustedSources)
+		upToDate = upToDate && err == nil && dodb.FirewallRulesUpToDate(desired, rules)
+	}
+
+	settingsUpToDate, err := c.observeEngineSettings(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	upToDate = upToDate && settingsUpToDate
+
+	if dodb.SupportsBackups(observed.EngineSlug) {
+		backups, _, err := c.Databases.ListBackups(ctx, observed.ID, nil)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errListBackups)
+		}
+		cr.Status.AtProvider.Backups = dodb.GenerateBackupObservations(backups)
+	}
+
+	// Connection details are published on every observation so that a
+	// deleted or outdated connection secret is restored.
+	var ca *godo.DatabaseCA
+	if cr.Spec.WriteConnectionSecretToReference != nil {
+		if ca, _, err = c.Databases.GetCA(ctx, observed.ID); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetCA)
+		}
+	}
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: dodb.GenerateClusterConnectionDetails(cr.Spec.ForProvider, observed, ca),
 	}, nil
 }
 
+// observeEngineSettings records the engine specific settings of the supplied
+// Database Cluster that are managed, and returns whether they are up to date.
+// Settings that don't match the engine of the cluster are never up to date so
+// that they are rejected by Update.
+func (c *dbExternal) observeEngineSettings(ctx context.Context, cr *v1alpha1.DODatabaseCluster) (bool, error) {
+	p := cr.Spec.ForProvider
+	if dodb.ValidateEngineSettings(p) != nil {
+		return false, nil
+	}
+	// The settings of a cluster can only be read once it is online.
+	if cr.Status.AtProvider.Status != v1alpha1.StatusOnline {
+		return true, nil
+	}
+
+	id := meta.GetExternalName(cr)
+	upToDate := true
+	if policy := dodb.DesiredEvictionPolicy(p); policy != "" {
+		observed, _, err := c.Databases.GetEvictionPolicy(ctx, id)
+		if err != nil {
+			return false, errors.Wrap(err, errGetEvictionPolicy)
+		}
+		cr.Status.AtProvider.EvictionPolicy = observed
+		upToDate = observed == policy
+	}
+	if p.MySQL != nil && p.MySQL.SQLModes != nil {
+		observed, _, err := c.Databases.GetSQLMode(ctx, id)
+		if err != nil {
+			return false, errors.Wrap(err, errGetSQLMode)
+		}
+		cr.Status.AtProvider.SQLModes = dodb.ParseSQLModes(observed)
+		upToDate = upToDate && dodb.SQLModesUpToDate(p, cr.Status.AtProvider.SQLModes)
+	}
+	return upToDate, nil
+}
+
 func setCrossplaneStatus(cr *v1alpha1.DODatabaseCluster) {
 	switch cr.Status.AtProvider.Status {
 	case v1alpha1.StatusCreating:
 		cr.SetConditions(xpv1.Creating())
 	case v1alpha1.StatusOnline:
 		cr.SetConditions(xpv1.Available())
+		if cr.GetCondition(v1alpha1.TypeClusterUpdate).Reason != "" {
+			cr.SetConditions(v1alpha1.UpdateComplete())
+		}
 	case v1alpha1.StatusMigrating:
+		// Clusters keep serving requests while they are resized or migrated.
+		cr.SetConditions(xpv1.Available(), v1alpha1.Migrating())
 	case v1alpha1.StatusResizing:
+		cr.SetConditions(xpv1.Available(), v1alpha1.Resizing())
 	case v1alpha1.StatusForking:
-		cr.SetConditions(xpv1.Unavailable())
+		cr.SetConditions(v1alpha1.Forking())
+	default:
+		cr.SetConditions(v1alpha1.UnknownStatus(cr.Status.AtProvider.Status))
 	}
 }
 
+func setMaintenanceStatus(cr *v1alpha1.DODatabaseCluster) {
+	w := cr.Status.AtProvider.MaintenanceWindow
+	switch {
+	case !w.Pending:
+		if cr.GetCondition(v1alpha1.TypeMaintenancePending).Reason != "" {
+			cr.SetConditions(v1alpha1.NoMaintenancePending())
+		}
+	case cr.GetCondition(v1alpha1.TypeMaintenancePending).Reason != v1alpha1.ReasonInstallingUpdates:
+		cr.SetConditions(v1alpha1.MaintenancePending(strings.Join(w.Description, "; ")))
+	}
+}
+
+// validateEngineSettings validates the engine specific settings of the
+// supplied Database Cluster and records the outcome in its conditions.
+func validateEngineSettings(cr *v1alpha1.DODatabaseCluster) error {
+	return do.RecordValidation(cr, dodb.ValidateEngineSettings(cr.Spec.ForProvider), errInvalidEngineSettings)
+}
+
+// installUpdateDue returns true if the pending updates of the supplied Database
+// Cluster should be installed and their installation was not yet requested.
+func installUpdateDue(cr *v1alpha1.DODatabaseCluster, now time.Time) bool {
+	return cr.GetCondition(v1alpha1.TypeMaintenancePending).Reason != v1alpha1.ReasonInstallingUpdates &&
+		dodb.InstallUpdateDue(cr.Spec.ForProvider, cr.Status.AtProvider.MaintenanceWindow, now)
+}
+
 func (c *dbExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.DODatabaseCluster)
 	if !ok {
@@ -203,6 +339,10 @@ func (c *dbExternal) Create(ctx context.Context, mg resource.Managed) (managed.E
 		return managed.ExternalCreation{}, errors.New(errDBNameRequired)
 	}
 
+	if err := validateEngineSettings(cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	dodb.GenerateDatabase(name, cr.Spec.ForProvider, create)
 
 	db, _, err := c.Databases.Create(ctx, create)
@@ -212,26 +352,115 @@ func (c *dbExternal) Create(ctx context.Context, mg resource.Managed) (managed.E
 
 	meta.SetExternalName(cr, db.ID)
 
-	ec := managed.ExternalCreation{}
+	return managed.ExternalCreation{
+		ConnectionDetails: dodb.GenerateClusterConnectionDetails(cr.Spec.ForProvider, db, nil),
+	}, nil
+}
 
-	if cr.Spec.WriteConnectionSecretToReference != nil {
-		ec.ConnectionDetails = managed.ConnectionDetails{
-			xpv1.ResourceCredentialsSecretEndpointKey: []byte(db.Connection.URI),
-			"host":                                    []byte(db.Connection.Host),
-			xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(db.Connection.Port)),
-			xpv1.ResourceCredentialsSecretUserKey:     []byte(db.Connection.User),
-			xpv1.ResourceCredentialsSecretPasswordKey: []byte(db.Connection.Password),
+func (c *dbExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DODatabaseCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDB)
+	}
+
+	id := meta.GetExternalName(cr)
+	observed := cr.Status.AtProvider
+
+	if err := validateEngineSettings(cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if maintenance := dodb.GenerateMaintenanceRequest(cr.Spec.ForProvider, observed); maintenance != nil {
+		if _, err := c.Databases.UpdateMaintenance(ctx, id, maintenance); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDBUpdateMaintenance)
 		}
 	}
 
-	return ec, nil
-}
+	if installUpdateDue(cr, time.Now()) {
+		if _, err := dodb.InstallUpdate(ctx, c.Client, id); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errInstallUpdate)
+		}
+		cr.SetConditions(v1alpha1.InstallingUpdates())
+	}
+
+	if cr.Spec.ForProvider.TrustedSources != nil {
+		if err := c.updateFirewallRules(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if err := c.updateEngineSettings(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// A cluster can only be resized or migrated at a time, so a migration
+	// is started once a resize has completed. While either operation is in
+	// progress the cluster reports its previous size and region.
+	if observed.Status == v1alpha1.StatusResizing || observed.Status == v1alpha1.StatusMigrating {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if resize := dodb.GenerateResizeRequest(cr.Spec.ForProvider, observed); resize != nil {
+		if _, err := c.Databases.Resize(ctx, id, resize); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDBResize)
+		}
+		cr.SetConditions(v1alpha1.Resizing())
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if migrate := dodb.GenerateMigrateRequest(cr.Spec.ForProvider, observed); migrate != nil {
+		if _, err := c.Databases.Migrate(ctx, id, migrate); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDBMigrate)
+		}
+		cr.SetConditions(v1alpha1.Migrating())
+	}
 
-func (c *dbExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	// We don't allow the updating of databases right now.
 	return managed.ExternalUpdate{}, nil
 }
 
+func (c *dbExternal) updateFirewallRules(ctx context.Context, cr *v1alpha1.DODatabaseCluster) error {
+	rules, err := dodb.GenerateFirewallRules(cr.Spec.ForProvider.TrustedSources)
+	if err := do.RecordValidation(cr, err, errInvalidTrustedSources); err != nil {
+		return err
+	}
+
+	if dodb.FirewallRulesUpToDate(rules, firewallRules(cr.Status.AtProvider.TrustedSources)) {
+		return nil
+	}
+
+	_, err = c.Databases.UpdateFirewallRules(ctx, meta.GetExternalName(cr), &godo.DatabaseUpdateFirewallRulesRequest{Rules: rules})
+	return errors.Wrap(err, errUpdateFirewallRules)
+}
+
+func (c *dbExternal) updateEngineSettings(ctx context.Context, cr *v1alpha1.DODatabaseCluster) error {
+	p := cr.Spec.ForProvider
+	observed := cr.Status.AtProvider
+	if observed.Status != v1alpha1.StatusOnline {
+		return nil
+	}
+
+	id := meta.GetExternalName(cr)
+	if policy := dodb.DesiredEvictionPolicy(p); policy != "" && policy != observed.EvictionPolicy {
+		if _, err := c.Databases.SetEvictionPolicy(ctx, id, policy); err != nil {
+			return errors.Wrap(err, errSetEvictionPolicy)
+		}
+	}
+	if !dodb.SQLModesUpToDate(p, observed.SQLModes) {
+		if _, err := c.Databases.SetSQLMode(ctx, id, p.MySQL.SQLModes...); err != nil {
+			return errors.Wrap(err, errSetSQLMode)
+		}
+	}
+	return nil
+}
+
+func firewallRules(observed []v1alpha1.DODatabaseClusterFirewallRule) []godo.DatabaseFirewallRule {
+	rules := make([]godo.DatabaseFirewallRule, len(observed))
+	for i, r := range observed {
+		rules[i] = godo.DatabaseFirewallRule{UUID: r.UUID, Type: r.Type, Value: r.Value}
+	}
+	return rules
+}
+
 func (c *dbExternal) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.DODatabaseCluster)
 	if !ok {
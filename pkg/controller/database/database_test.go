@@ -15,3 +15,299 @@ limitations under the License.
 */
 
 package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	dov1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/v1alpha1"
+	dodb "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/database"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/database/fake"
+)
+
+const (
+	name       = "test"
+	clusterID  = "cluster"
+	region     = "nyc3"
+	size       = "db-s-1vcpu-1gb"
+	largerSize = "db-s-2vcpu-4gb"
+)
+
+var errBoom = errors.New("boom")
+
+type clusterModifier func(*v1alpha1.DODatabaseCluster)
+
+func withClusterExternalName(n string) clusterModifier {
+	return func(cr *v1alpha1.DODatabaseCluster) { meta.SetExternalName(cr, n) }
+}
+
+func withClusterSpec(p v1alpha1.DODatabaseClusterParameters) clusterModifier {
+	return func(cr *v1alpha1.DODatabaseCluster) { cr.Spec.ForProvider = p }
+}
+
+func withClusterStatus(o v1alpha1.DODatabaseClusterObservation) clusterModifier {
+	return func(cr *v1alpha1.DODatabaseCluster) { cr.Status.AtProvider = o }
+}
+
+func withClusterConditions(c ...xpv1.Condition) clusterModifier {
+	return func(cr *v1alpha1.DODatabaseCluster) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func cluster(m ...clusterModifier) *v1alpha1.DODatabaseCluster {
+	cr := &v1alpha1.DODatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	meta.SetExternalName(cr, clusterID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func Test_dbExternal_ObserveNotCreated(t *testing.T) {
+	redis := v1alpha1.EngineRedis
+	policy := "allkeys_lru"
+	valid := v1alpha1.DODatabaseClusterParameters{Engine: &redis, Redis: &v1alpha1.DODatabaseClusterRedisParameters{EvictionPolicy: &policy}}
+	invalid := v1alpha1.DODatabaseClusterParameters{Engine: &redis, MySQL: &v1alpha1.DODatabaseClusterMySQLParameters{SQLModes: []string{"ANSI"}}}
+	invalidErr := dodb.ValidateEngineSettings(invalid)
+
+	type want struct {
+		cr     *v1alpha1.DODatabaseCluster
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		cr   *v1alpha1.DODatabaseCluster
+		want want
+	}{
+		"Valid": {
+			cr: cluster(withClusterExternalName(""), withClusterSpec(valid)),
+			want: want{
+				cr: cluster(withClusterExternalName(""), withClusterSpec(valid)),
+			},
+		},
+		"Invalid": {
+			cr: cluster(withClusterExternalName(""), withClusterSpec(invalid)),
+			want: want{
+				cr:  cluster(withClusterExternalName(""), withClusterSpec(invalid), withClusterConditions(dov1alpha1.ParametersRejected(invalidErr))),
+				err: errors.Wrap(invalidErr, errInvalidEngineSettings),
+			},
+		},
+		"Corrected": {
+			cr: cluster(withClusterExternalName(""), withClusterSpec(valid), withClusterConditions(dov1alpha1.ParametersRejected(invalidErr))),
+			want: want{
+				cr: cluster(withClusterExternalName(""), withClusterSpec(valid), withClusterConditions(dov1alpha1.ParametersAccepted())),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &dbExternal{Client: &godo.Client{Databases: &fake.MockDatabasesService{}}}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_dbExternal_Update(t *testing.T) {
+	spec := v1alpha1.DODatabaseClusterParameters{Region: region, Size: largerSize, NumNodes: 1}
+	migrated := v1alpha1.DODatabaseClusterParameters{Region: "ams3", Size: size, NumNodes: 1}
+	online := v1alpha1.DODatabaseClusterObservation{Status: v1alpha1.StatusOnline, Region: region, Size: size, NumNodes: 1}
+	resizing := online
+	resizing.Status = v1alpha1.StatusResizing
+	migrating := online
+	migrating.Status = v1alpha1.StatusMigrating
+
+	type args struct {
+		cr        *v1alpha1.DODatabaseCluster
+		resizeErr error
+	}
+	type want struct {
+		cr      *v1alpha1.DODatabaseCluster
+		resize  *godo.DatabaseResizeRequest
+		migrate *godo.DatabaseMigrateRequest
+		err     error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"Resize": {
+			args: args{
+				cr: cluster(withClusterSpec(spec), withClusterStatus(online)),
+			},
+			want: want{
+				cr:     cluster(withClusterSpec(spec), withClusterStatus(online), withClusterConditions(v1alpha1.Resizing())),
+				resize: &godo.DatabaseResizeRequest{SizeSlug: largerSize, NumNodes: 1},
+			},
+		},
+		"ResizeFailed": {
+			args: args{
+				cr:        cluster(withClusterSpec(spec), withClusterStatus(online)),
+				resizeErr: errBoom,
+			},
+			want: want{
+				cr:     cluster(withClusterSpec(spec), withClusterStatus(online)),
+				resize: &godo.DatabaseResizeRequest{SizeSlug: largerSize, NumNodes: 1},
+				err:    errors.Wrap(errBoom, errDBResize),
+			},
+		},
+		"Migrate": {
+			args: args{
+				cr: cluster(withClusterSpec(migrated), withClusterStatus(online)),
+			},
+			want: want{
+				cr:      cluster(withClusterSpec(migrated), withClusterStatus(online), withClusterConditions(v1alpha1.Migrating())),
+				migrate: &godo.DatabaseMigrateRequest{Region: "ams3"},
+			},
+		},
+		"StillResizing": {
+			args: args{
+				cr: cluster(withClusterSpec(spec), withClusterStatus(resizing)),
+			},
+			want: want{
+				cr: cluster(withClusterSpec(spec), withClusterStatus(resizing)),
+			},
+		},
+		"StillMigrating": {
+			args: args{
+				cr: cluster(withClusterSpec(migrated), withClusterStatus(migrating)),
+			},
+			want: want{
+				cr: cluster(withClusterSpec(migrated), withClusterStatus(migrating)),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var resize *godo.DatabaseResizeRequest
+			var migrate *godo.DatabaseMigrateRequest
+			e := &dbExternal{Client: &godo.Client{Databases: &fake.MockDatabasesService{
+				MockResize: func(_ context.Context, _ string, r *godo.DatabaseResizeRequest) (*godo.Response, error) {
+					resize = r
+					return nil, tc.args.resizeErr
+				},
+				MockMigrate: func(_ context.Context, _ string, m *godo.DatabaseMigrateRequest) (*godo.Response, error) {
+					migrate = m
+					return nil, nil
+				},
+			}}}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.resize, resize); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.migrate, migrate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_dbExternal_UpdateTrustedSources(t *testing.T) {
+	ip := "192.0.2.10"
+	online := v1alpha1.DODatabaseClusterObservation{
+		Status:   v1alpha1.StatusOnline,
+		Region:   region,
+		Size:     size,
+		NumNodes: 1,
+		TrustedSources: []v1alpha1.DODatabaseClusterFirewallRule{
+			{UUID: "uuid", Type: dodb.FirewallRuleTypeIPAddr, Value: ip},
+		},
+	}
+	spec := func(ts []v1alpha1.DODatabaseClusterTrustedSource) v1alpha1.DODatabaseClusterParameters {
+		return v1alpha1.DODatabaseClusterParameters{Region: region, Size: size, NumNodes: 1, TrustedSources: ts}
+	}
+	unmanaged := spec(nil)
+	upToDate := spec([]v1alpha1.DODatabaseClusterTrustedSource{{IPAddr: &ip}})
+	cleared := spec([]v1alpha1.DODatabaseClusterTrustedSource{})
+	invalid := spec([]v1alpha1.DODatabaseClusterTrustedSource{{}})
+	_, invalidErr := dodb.GenerateFirewallRules(invalid.TrustedSources)
+
+	type want struct {
+		cr     *v1alpha1.DODatabaseCluster
+		update *godo.DatabaseUpdateFirewallRulesRequest
+		err    error
+	}
+	tests := map[string]struct {
+		cr   *v1alpha1.DODatabaseCluster
+		want want
+	}{
+		"Unmanaged": {
+			cr: cluster(withClusterSpec(unmanaged), withClusterStatus(online)),
+			want: want{
+				cr: cluster(withClusterSpec(unmanaged), withClusterStatus(online)),
+			},
+		},
+		"UpToDate": {
+			cr: cluster(withClusterSpec(upToDate), withClusterStatus(online)),
+			want: want{
+				cr: cluster(withClusterSpec(upToDate), withClusterStatus(online)),
+			},
+		},
+		"Cleared": {
+			cr: cluster(withClusterSpec(cleared), withClusterStatus(online)),
+			want: want{
+				cr:     cluster(withClusterSpec(cleared), withClusterStatus(online)),
+				update: &godo.DatabaseUpdateFirewallRulesRequest{Rules: []*godo.DatabaseFirewallRule{}},
+			},
+		},
+		"Invalid": {
+			cr: cluster(withClusterSpec(invalid), withClusterStatus(online)),
+			want: want{
+				cr:  cluster(withClusterSpec(invalid), withClusterStatus(online), withClusterConditions(dov1alpha1.ParametersRejected(invalidErr))),
+				err: errors.Wrap(invalidErr, errInvalidTrustedSources),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var update *godo.DatabaseUpdateFirewallRulesRequest
+			e := &dbExternal{Client: &godo.Client{Databases: &fake.MockDatabasesService{
+				MockUpdateFirewallRules: func(_ context.Context, _ string, r *godo.DatabaseUpdateFirewallRulesRequest) (*godo.Response, error) {
+					update = r
+					return nil, nil
+				},
+			}}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.update, update); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+	dodb "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/database"
+)
+
+const (
+	// Error strings.
+	errNotDBUser          = "managed resource is not a Database User resource"
+	errDBUserNoCluster    = "Database Cluster of Database User is not set"
+	errGetDBUser          = "cannot get a Database User"
+	errGetDBUserCluster   = "cannot get the Database Cluster of a Database User"
+	errDBUserCreateFailed = "creation of Database User resource has failed"
+	errDBUserDeleteFailed = "deletion of Database User resource has failed"
+	errDBUserResetAuth    = "cannot reset authentication of Database User"
+	errInvalidDBUser      = "invalid Database User parameters"
+)
+
+// SetupDatabaseUser adds a controller that reconciles DODatabaseUser managed
+// resources.
+func SetupDatabaseUser(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DODatabaseUserGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DODatabaseUser{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DODatabaseUserGroupVersionKind),
+			managed.WithExternalConnecter(&dbUserConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type dbUserConnector struct {
+	kube client.Client
+}
+
+func (c *dbUserConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &dbUserExternal{Client: client, kube: c.kube}, nil
+}
+
+type dbUserExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+func (c *dbUserExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DODatabaseUser)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDBUser)
+	}
+
+	clusterID := do.StringValue(cr.Spec.ForProvider.ClusterID)
+	if clusterID == "" {
+		return managed.ExternalObservation{}, errors.New(errDBUserNoCluster)
+	}
+
+	cluster, _, err := c.Databases.Get(ctx, clusterID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetDBUserCluster)
+	}
+	if err := do.RecordValidation(cr, dodb.ValidateUserEngine(cr.Spec.ForProvider, cluster.EngineSlug), errInvalidDBUser); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	observed, response, err := c.Databases.GetUser(ctx, clusterID, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(do.IgnoreNotFound(err, response), errGetDBUser)
+	}
+
+	// A user that is observed for the first time adopts the desired password
+	// generation, so that its password is only reset when it is increased.
+	previous := cr.Status.AtProvider
+	if previous.Name == "" {
+		previous.PasswordGeneration = cr.Spec.ForProvider.PasswordGeneration
+	}
+	cr.Status.AtProvider = dodb.GenerateUserObservation(observed, previous)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  !dodb.UserAuthResetDue(cr.Spec.ForProvider, cr.Status.AtProvider),
+		ConnectionDetails: dodb.GenerateUserConnectionDetails(observed, cluster),
+	}, nil
+}
+
+func (c *dbUserExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DODatabaseUser)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDBUser)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	clusterID := do.StringValue(cr.Spec.ForProvider.ClusterID)
+	user, _, err := c.Databases.CreateUser(ctx, clusterID, dodb.GenerateCreateUserRequest(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	if err != nil || user == nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errDBUserCreateFailed)
+	}
+
+	cr.Status.AtProvider = dodb.GenerateUserObservation(user, v1alpha1.DODatabaseUserObservation{
+		PasswordGeneration: cr.Spec.ForProvider.PasswordGeneration,
+	})
+
+	cluster, _, err := c.Databases.Get(ctx, clusterID)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetDBUserCluster)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: dodb.GenerateUserConnectionDetails(user, cluster),
+	}, nil
+}
+
+func (c *dbUserExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DODatabaseUser)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDBUser)
+	}
+
+	if !dodb.UserAuthResetDue(cr.Spec.ForProvider, cr.Status.AtProvider) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	clusterID := do.StringValue(cr.Spec.ForProvider.ClusterID)
+	user, _, err := c.Databases.ResetUserAuth(ctx, clusterID, meta.GetExternalName(cr), dodb.GenerateResetUserAuthRequest(cr.Spec.ForProvider))
+	if err != nil || user == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDBUserResetAuth)
+	}
+
+	now := metav1.Now()
+	cr.Status.AtProvider = dodb.GenerateUserObservation(user, v1alpha1.DODatabaseUserObservation{
+		PasswordGeneration: cr.Spec.ForProvider.PasswordGeneration,
+		PasswordResetAt:    &now,
+	})
+
+	cluster, _, err := c.Databases.Get(ctx, clusterID)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetDBUserCluster)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: dodb.GenerateUserConnectionDetails(user, cluster),
+	}, nil
+}
+
+func (c *dbUserExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DODatabaseUser)
+	if !ok {
+		return errors.New(errNotDBUser)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	response, err := c.Databases.DeleteUser(ctx, do.StringValue(cr.Spec.ForProvider.ClusterID), meta.GetExternalName(cr))
+	return errors.Wrap(do.IgnoreNotFound(err, response), errDBUserDeleteFailed)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+)
+
+const (
+	// Error strings.
+	errNotLogicalDB          = "managed resource is not a Database resource"
+	errLogicalDBNoCluster    = "Database Cluster of Database is not set"
+	errGetLogicalDB          = "cannot get a Database"
+	errLogicalDBCreateFailed = "creation of Database resource has failed"
+	errLogicalDBDeleteFailed = "deletion of Database resource has failed"
+)
+
+// SetupLogicalDatabase adds a controller that reconciles DODatabase managed
+// resources.
+func SetupLogicalDatabase(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DODatabaseGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DODatabase{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DODatabaseGroupVersionKind),
+			managed.WithExternalConnecter(&logicalDBConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type logicalDBConnector struct {
+	kube client.Client
+}
+
+func (c *logicalDBConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &logicalDBExternal{Client: client, kube: c.kube}, nil
+}
+
+type logicalDBExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+func (c *logicalDBExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DODatabase)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLogicalDB)
+	}
+
+	clusterID := do.StringValue(cr.Spec.ForProvider.ClusterID)
+	if clusterID == "" {
+		return managed.ExternalObservation{}, errors.New(errLogicalDBNoCluster)
+	}
+
+	observed, response, err := c.Databases.GetDB(ctx, clusterID, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(do.IgnoreNotFound(err, response), errGetLogicalDB)
+	}
+
+	cr.Status.AtProvider = v1alpha1.DODatabaseObservation{
+		Name: observed.Name,
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (c *logicalDBExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DODatabase)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLogicalDB)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	create := &godo.DatabaseCreateDBRequest{Name: meta.GetExternalName(cr)}
+	db, _, err := c.Databases.CreateDB(ctx, do.StringValue(cr.Spec.ForProvider.ClusterID), create)
+	if err != nil || db == nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errLogicalDBCreateFailed)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *logicalDBExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// A database has no properties that can be updated.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *logicalDBExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DODatabase)
+	if !ok {
+		return errors.New(errNotLogicalDB)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	response, err := c.Databases.DeleteDB(ctx, do.StringValue(cr.Spec.ForProvider.ClusterID), meta.GetExternalName(cr))
+	return errors.Wrap(do.IgnoreNotFound(err, response), errLogicalDBDeleteFailed)
+}
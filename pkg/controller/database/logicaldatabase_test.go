@@ -0,0 +1,208 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/database/fake"
+)
+
+const logicalDBName = "app"
+
+type logicalDBModifier func(*v1alpha1.DODatabase)
+
+func withLogicalDBSpec(p v1alpha1.DODatabaseParameters) logicalDBModifier {
+	return func(cr *v1alpha1.DODatabase) { cr.Spec.ForProvider = p }
+}
+
+func withLogicalDBStatus(o v1alpha1.DODatabaseObservation) logicalDBModifier {
+	return func(cr *v1alpha1.DODatabase) { cr.Status.AtProvider = o }
+}
+
+func withLogicalDBConditions(c ...xpv1.Condition) logicalDBModifier {
+	return func(cr *v1alpha1.DODatabase) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func logicalDB(m ...logicalDBModifier) *v1alpha1.DODatabase {
+	cr := &v1alpha1.DODatabase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: logicalDBName,
+		},
+		Spec: v1alpha1.DODatabaseSpec{
+			ForProvider: v1alpha1.DODatabaseParameters{ClusterID: godo.String(clusterID)},
+		},
+	}
+	meta.SetExternalName(cr, logicalDBName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func Test_logicalDBExternal_Observe(t *testing.T) {
+	type args struct {
+		cr      *v1alpha1.DODatabase
+		getErr  error
+		getResp *godo.Response
+	}
+	type want struct {
+		cr     *v1alpha1.DODatabase
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"NoCluster": {
+			args: args{
+				cr: logicalDB(withLogicalDBSpec(v1alpha1.DODatabaseParameters{})),
+			},
+			want: want{
+				cr:  logicalDB(withLogicalDBSpec(v1alpha1.DODatabaseParameters{})),
+				err: errors.New(errLogicalDBNoCluster),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cr:      logicalDB(),
+				getErr:  errBoom,
+				getResp: notFound(),
+			},
+			want: want{
+				cr: logicalDB(),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				cr:     logicalDB(),
+				getErr: errBoom,
+			},
+			want: want{
+				cr:  logicalDB(),
+				err: errors.Wrap(errBoom, errGetLogicalDB),
+			},
+		},
+		"Exists": {
+			args: args{
+				cr: logicalDB(),
+			},
+			want: want{
+				cr: logicalDB(withLogicalDBStatus(v1alpha1.DODatabaseObservation{Name: logicalDBName}),
+					withLogicalDBConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &logicalDBExternal{Client: &godo.Client{Databases: &fake.MockDatabasesService{
+				MockGetDB: func(_ context.Context, cluster, db string) (*godo.DatabaseDB, *godo.Response, error) {
+					if cluster != clusterID || db != logicalDBName {
+						t.Errorf("unexpected database %s/%s observed", cluster, db)
+					}
+					if tc.args.getErr != nil {
+						return nil, tc.args.getResp, tc.args.getErr
+					}
+					return &godo.DatabaseDB{Name: db}, nil, nil
+				},
+			}}}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_logicalDBExternal_Create(t *testing.T) {
+	tests := map[string]struct {
+		createErr error
+		want      error
+	}{
+		"Created": {},
+		"CreateFailed": {
+			createErr: errBoom,
+			want:      errors.Wrap(errBoom, errLogicalDBCreateFailed),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var created *godo.DatabaseCreateDBRequest
+			e := &logicalDBExternal{Client: &godo.Client{Databases: &fake.MockDatabasesService{
+				MockCreateDB: func(_ context.Context, _ string, create *godo.DatabaseCreateDBRequest) (*godo.DatabaseDB, *godo.Response, error) {
+					created = create
+					if tc.createErr != nil {
+						return nil, nil, tc.createErr
+					}
+					return &godo.DatabaseDB{Name: create.Name}, nil, nil
+				},
+			}}}
+			_, err := e.Create(context.Background(), logicalDB())
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(&godo.DatabaseCreateDBRequest{Name: logicalDBName}, created); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_logicalDBExternal_Delete(t *testing.T) {
+	type args struct {
+		deleteErr  error
+		deleteResp *godo.Response
+	}
+	tests := map[string]struct {
+		args args
+		want error
+	}{
+		"Deleted": {
+			args: args{},
+		},
+		"AlreadyGone": {
+			args: args{deleteErr: errBoom, deleteResp: notFound()},
+		},
+		"DeleteFailed": {
+			args: args{deleteErr: errBoom},
+			want: errors.Wrap(errBoom, errLogicalDBDeleteFailed),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &logicalDBExternal{Client: &godo.Client{Databases: &fake.MockDatabasesService{
+				MockDeleteDB: func(_ context.Context, cluster, db string) (*godo.Response, error) {
+					if cluster != clusterID || db != logicalDBName {
+						t.Errorf("unexpected database %s/%s deleted", cluster, db)
+					}
+					return tc.args.deleteResp, tc.args.deleteErr
+				},
+			}}}
+			err := e.Delete(context.Background(), logicalDB())
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
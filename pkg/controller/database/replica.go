@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+	dodb "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/database"
+)
+
+const (
+	// Error strings.
+	errNotReplica          = "managed resource is not a Replica resource"
+	errReplicaNoCluster    = "Database Cluster of Replica is not set"
+	errGetReplica          = "cannot get a Replica"
+	errGetReplicaCluster   = "cannot get the Database Cluster of a Replica"
+	errReplicaUpdate       = "cannot update managed Replica resource"
+	errReplicaCreateFailed = "creation of Replica resource has failed"
+	errReplicaDeleteFailed = "deletion of Replica resource has failed"
+)
+
+// SetupReplica adds a controller that reconciles DODatabaseReplica managed
+// resources.
+func SetupReplica(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DODatabaseReplicaGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DODatabaseReplica{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DODatabaseReplicaGroupVersionKind),
+			managed.WithExternalConnecter(&replicaConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type replicaConnector struct {
+	kube client.Client
+}
+
+func (c *replicaConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &replicaExternal{Client: client, kube: c.kube}, nil
+}
+
+type replicaExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+func (c *replicaExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DODatabaseReplica)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotReplica)
+	}
+
+	clusterID := do.StringValue(cr.Spec.ForProvider.ClusterID)
+	if clusterID == "" {
+		return managed.ExternalObservation{}, errors.New(errReplicaNoCluster)
+	}
+
+	observed, response, err := c.Databases.GetReplica(ctx, clusterID, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(do.IgnoreNotFound(err, response), errGetReplica)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	dodb.ReplicaLateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errReplicaUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = dodb.GenerateReplicaObservation(observed)
+
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.StatusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.StatusOnline:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: dodb.GenerateConnectionDetails(observed.Connection, observed.PrivateConnection),
+	}, nil
+}
+
+func (c *replicaExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DODatabaseReplica)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotReplica)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	clusterID := do.StringValue(cr.Spec.ForProvider.ClusterID)
+
+	// A replica without a region is placed in the region of its cluster.
+	clusterRegion := ""
+	if cr.Spec.ForProvider.Region == nil {
+		cluster, _, err := c.Databases.Get(ctx, clusterID)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGetReplicaCluster)
+		}
+		clusterRegion = cluster.RegionSlug
+	}
+
+	create := dodb.GenerateCreateReplicaRequest(meta.GetExternalName(cr), cr.Spec.ForProvider, clusterRegion)
+	replica, _, err := c.Databases.CreateReplica(ctx, clusterID, create)
+	if err != nil || replica == nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errReplicaCreateFailed)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: dodb.GenerateConnectionDetails(replica.Connection, replica.PrivateConnection),
+	}, nil
+}
+
+func (c *replicaExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// The parameters of a replica cannot be updated.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *replicaExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DODatabaseReplica)
+	if !ok {
+		return errors.New(errNotReplica)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	response, err := c.Databases.DeleteReplica(ctx, do.StringValue(cr.Spec.ForProvider.ClusterID), meta.GetExternalName(cr))
+	return errors.Wrap(do.IgnoreNotFound(err, response), errReplicaDeleteFailed)
+}
@@ -0,0 +1,264 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/database/v1alpha1"
+	dodb "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/database"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/database/fake"
+)
+
+const replicaName = "replica"
+
+type replicaModifier func(*v1alpha1.DODatabaseReplica)
+
+func withReplicaSpec(p v1alpha1.DODatabaseReplicaParameters) replicaModifier {
+	return func(cr *v1alpha1.DODatabaseReplica) { cr.Spec.ForProvider = p }
+}
+
+func withReplicaStatus(o v1alpha1.DODatabaseReplicaObservation) replicaModifier {
+	return func(cr *v1alpha1.DODatabaseReplica) { cr.Status.AtProvider = o }
+}
+
+func withReplicaConditions(c ...xpv1.Condition) replicaModifier {
+	return func(cr *v1alpha1.DODatabaseReplica) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func replica(m ...replicaModifier) *v1alpha1.DODatabaseReplica {
+	cr := &v1alpha1.DODatabaseReplica{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: replicaName,
+		},
+	}
+	meta.SetExternalName(cr, replicaName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func Test_replicaExternal_Observe(t *testing.T) {
+	spec := v1alpha1.DODatabaseReplicaParameters{
+		ClusterID:          godo.String(clusterID),
+		Region:             godo.String(region),
+		Size:               size,
+		PrivateNetworkUUID: godo.String("vpc"),
+	}
+	online := &godo.DatabaseReplica{Name: replicaName, Region: region, Status: v1alpha1.StatusOnline, PrivateNetworkUUID: "vpc"}
+	creating := &godo.DatabaseReplica{Name: replicaName, Region: region, Status: v1alpha1.StatusCreating, PrivateNetworkUUID: "vpc"}
+
+	type args struct {
+		cr      *v1alpha1.DODatabaseReplica
+		replica *godo.DatabaseReplica
+		getErr  error
+		getResp *godo.Response
+	}
+	type want struct {
+		cr     *v1alpha1.DODatabaseReplica
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"NoCluster": {
+			args: args{
+				cr: replica(),
+			},
+			want: want{
+				cr:  replica(),
+				err: errors.New(errReplicaNoCluster),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cr:      replica(withReplicaSpec(spec)),
+				getErr:  errBoom,
+				getResp: notFound(),
+			},
+			want: want{
+				cr: replica(withReplicaSpec(spec)),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				cr:     replica(withReplicaSpec(spec)),
+				getErr: errBoom,
+			},
+			want: want{
+				cr:  replica(withReplicaSpec(spec)),
+				err: errors.Wrap(errBoom, errGetReplica),
+			},
+		},
+		"Creating": {
+			args: args{
+				cr:      replica(withReplicaSpec(spec)),
+				replica: creating,
+			},
+			want: want{
+				cr: replica(withReplicaSpec(spec), withReplicaStatus(dodb.GenerateReplicaObservation(creating)),
+					withReplicaConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: dodb.GenerateConnectionDetails(nil, nil),
+				},
+			},
+		},
+		"Online": {
+			args: args{
+				cr:      replica(withReplicaSpec(spec)),
+				replica: online,
+			},
+			want: want{
+				cr: replica(withReplicaSpec(spec), withReplicaStatus(dodb.GenerateReplicaObservation(online)),
+					withReplicaConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: dodb.GenerateConnectionDetails(nil, nil),
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &replicaExternal{Client: &godo.Client{Databases: &fake.MockDatabasesService{
+				MockGetReplica: func(context.Context, string, string) (*godo.DatabaseReplica, *godo.Response, error) {
+					return tc.args.replica, tc.args.getResp, tc.args.getErr
+				},
+			}}}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_replicaExternal_Create(t *testing.T) {
+	withoutRegion := v1alpha1.DODatabaseReplicaParameters{ClusterID: godo.String(clusterID), Size: size}
+	withRegion := v1alpha1.DODatabaseReplicaParameters{ClusterID: godo.String(clusterID), Region: godo.String("ams3"), Size: size}
+
+	type args struct {
+		cr     *v1alpha1.DODatabaseReplica
+		getErr error
+	}
+	type want struct {
+		create *godo.DatabaseCreateReplicaRequest
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"RegionOfCluster": {
+			args: args{
+				cr: replica(withReplicaSpec(withoutRegion)),
+			},
+			want: want{
+				create: &godo.DatabaseCreateReplicaRequest{Name: replicaName, Region: region, Size: size},
+			},
+		},
+		"GetClusterFailed": {
+			args: args{
+				cr:     replica(withReplicaSpec(withoutRegion)),
+				getErr: errBoom,
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetReplicaCluster),
+			},
+		},
+		"RegionSpecified": {
+			args: args{
+				cr: replica(withReplicaSpec(withRegion)),
+			},
+			want: want{
+				create: &godo.DatabaseCreateReplicaRequest{Name: replicaName, Region: "ams3", Size: size},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var create *godo.DatabaseCreateReplicaRequest
+			e := &replicaExternal{Client: &godo.Client{Databases: &fake.MockDatabasesService{
+				MockGet: func(context.Context, string) (*godo.Database, *godo.Response, error) {
+					if tc.args.getErr != nil {
+						return nil, nil, tc.args.getErr
+					}
+					return &godo.Database{ID: clusterID, RegionSlug: region}, nil, nil
+				},
+				MockCreateReplica: func(_ context.Context, _ string, r *godo.DatabaseCreateReplicaRequest) (*godo.DatabaseReplica, *godo.Response, error) {
+					create = r
+					return &godo.DatabaseReplica{Name: r.Name}, nil, nil
+				},
+			}}}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_replicaExternal_Delete(t *testing.T) {
+	type args struct {
+		deleteErr  error
+		deleteResp *godo.Response
+	}
+	tests := map[string]struct {
+		args args
+		want error
+	}{
+		"Deleted": {
+			args: args{},
+		},
+		"AlreadyGone": {
+			args: args{deleteErr: errBoom, deleteResp: notFound()},
+		},
+		"DeleteFailed": {
+			args: args{deleteErr: errBoom},
+			want: errors.Wrap(errBoom, errReplicaDeleteFailed),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &replicaExternal{Client: &godo.Client{Databases: &fake.MockDatabasesService{
+				MockDeleteReplica: func(_ context.Context, cluster, name string) (*godo.Response, error) {
+					if cluster != clusterID || name != replicaName {
+						t.Errorf("unexpected replica %s/%s deleted", cluster, name)
+					}
+					return tc.args.deleteResp, tc.args.deleteErr
+				},
+			}}}
+			err := e.Delete(context.Background(), replica(withReplicaSpec(v1alpha1.DODatabaseReplicaParameters{ClusterID: godo.String(clusterID)})))
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,8 +23,10 @@ import (
 	"github.com/crossplane-contrib/provider-digitalocean/pkg/controller/compute"
 	"github.com/crossplane-contrib/provider-digitalocean/pkg/controller/config"
 	"github.com/crossplane-contrib/provider-digitalocean/pkg/controller/database"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/controller/dns"
 	"github.com/crossplane-contrib/provider-digitalocean/pkg/controller/kubernetes"
 	"github.com/crossplane-contrib/provider-digitalocean/pkg/controller/loadbalancer"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/controller/vpc"
 )
 
 // Setup creates all DigitalOcean controllers with the supplied logger and adds them to
@@ -33,10 +35,23 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 	for _, setup := range []func(ctrl.Manager, logging.Logger) error{
 		config.Setup,
 		compute.SetupDroplet,
+		compute.SetupVolume,
+		compute.SetupVolumeAttachment,
+		compute.SetupDropletSnapshot,
+		compute.SetupVolumeSnapshot,
+		compute.SetupSnapshotSchedule,
+		compute.SetupFirewall,
 		database.SetupDatabase,
+		database.SetupDatabaseUser,
+		database.SetupLogicalDatabase,
+		database.SetupConnectionPool,
+		database.SetupReplica,
 		kubernetes.SetupKubernetesCluster,
 		kubernetes.SetupDOContainerRegistry,
 		loadbalancer.SetupLB,
+		vpc.SetupVPC,
+		dns.SetupDomain,
+		dns.SetupDomainRecord,
 	} {
 		if err := setup(mgr, l); err != nil {
 			return err
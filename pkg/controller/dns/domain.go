@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/dns/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+	dodns "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/dns"
+)
+
+const (
+	// Error strings.
+	errNotDomain     = "managed resource is not a Domain resource"
+	errGetDomain     = "cannot get Domain"
+	errListNSRecords = "cannot list NS records of Domain"
+
+	errDomainCreateFailed = "creation of Domain resource has failed"
+	errDomainDeleteFailed = "deletion of Domain resource has failed"
+
+	// listPageSize is the number of records requested per page.
+	listPageSize = 200
+)
+
+// SetupDomain adds a controller that reconciles Domain managed resources.
+func SetupDomain(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DomainGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Domain{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DomainGroupVersionKind),
+			managed.WithExternalConnecter(&domainConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type domainConnector struct {
+	kube client.Client
+}
+
+func (c *domainConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &domainExternal{Client: client, kube: c.kube}, nil
+}
+
+type domainExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+func (c *domainExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Domain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDomain)
+	}
+
+	// Existing Domains are imported as they are, so their records are never
+	// changed here.
+	observed, response, err := c.Domains.Get(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(do.IgnoreNotFound(err, response), errGetDomain)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	ns, err := c.nsRecords(ctx, observed.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListNSRecords)
+	}
+
+	cr.Status.AtProvider = dodns.GenerateDomainObservation(*observed, ns)
+
+	// Domains are usable as soon as they exist.
+	cr.SetConditions(xpv1.Available())
+
+	// The initial IP address is only used to create the Domain, so there is
+	// nothing to update.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// nsRecords returns the NS records of the Domain with the supplied name.
+func (c *domainExternal) nsRecords(ctx context.Context, name string) ([]godo.DomainRecord, error) {
+	var records []godo.DomainRecord
+	opts := &godo.ListOptions{Page: 1, PerPage: listPageSize}
+	for {
+		page, response, err := c.Domains.RecordsByType(ctx, name, v1alpha1.RecordTypeNS, opts)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, page...)
+		if response == nil || response.Links == nil || response.Links.IsLastPage() {
+			return records, nil
+		}
+		opts.Page++
+	}
+}
+
+func (c *domainExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Domain)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDomain)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	_, _, err := c.Domains.Create(ctx, dodns.GenerateDomain(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, errors.Wrap(err, errDomainCreateFailed)
+}
+
+func (c *domainExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// Domains cannot be updated.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *domainExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Domain)
+	if !ok {
+		return errors.New(errNotDomain)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	response, err := c.Domains.Delete(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(do.IgnoreNotFound(err, response), errDomainDeleteFailed)
+}
@@ -0,0 +1,254 @@
+package dns
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/dns/v1alpha1"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/dns/fake"
+)
+
+const domainName = "example.com"
+
+var errBoom = errors.New("boom")
+
+func notFound() *godo.Response {
+	return &godo.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+}
+
+type domainModifier func(*v1alpha1.Domain)
+
+func withDomainSpec(p v1alpha1.DomainParameters) domainModifier {
+	return func(cr *v1alpha1.Domain) { cr.Spec.ForProvider = p }
+}
+
+func withDomainStatus(o v1alpha1.DomainObservation) domainModifier {
+	return func(cr *v1alpha1.Domain) { cr.Status.AtProvider = o }
+}
+
+func withDomainConditions(c ...xpv1.Condition) domainModifier {
+	return func(cr *v1alpha1.Domain) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func domain(m ...domainModifier) *v1alpha1.Domain {
+	cr := &v1alpha1.Domain{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: domainName,
+		},
+	}
+	meta.SetExternalName(cr, domainName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func Test_domainExternal_Observe(t *testing.T) {
+	observed := &godo.Domain{Name: domainName, TTL: 1800, ZoneFile: "$ORIGIN example.com."}
+	pages := [][]godo.DomainRecord{
+		{
+			{Type: v1alpha1.RecordTypeNS, Name: "@", Data: "ns2.digitalocean.com"},
+			{Type: v1alpha1.RecordTypeNS, Name: "sub", Data: "ns.example.org"},
+		},
+		{
+			{Type: v1alpha1.RecordTypeNS, Name: "@", Data: "ns1.digitalocean.com"},
+		},
+	}
+
+	type args struct {
+		domain  *godo.Domain
+		getErr  error
+		getResp *godo.Response
+		nsErr   error
+	}
+	type want struct {
+		cr     *v1alpha1.Domain
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"NotFound": {
+			args: args{
+				getErr:  errBoom,
+				getResp: notFound(),
+			},
+			want: want{
+				cr: domain(),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				getErr: errBoom,
+			},
+			want: want{
+				cr:  domain(),
+				err: errors.Wrap(errBoom, errGetDomain),
+			},
+		},
+		"ListNSRecordsFailed": {
+			args: args{
+				domain: observed,
+				nsErr:  errBoom,
+			},
+			want: want{
+				cr:  domain(),
+				err: errors.Wrap(errBoom, errListNSRecords),
+			},
+		},
+		"Exists": {
+			args: args{
+				domain: observed,
+			},
+			want: want{
+				cr: domain(withDomainStatus(v1alpha1.DomainObservation{
+					Name:        domainName,
+					URN:         observed.URN(),
+					TTL:         1800,
+					ZoneFile:    "$ORIGIN example.com.",
+					NameServers: []string{"ns1.digitalocean.com", "ns2.digitalocean.com"},
+				}), withDomainConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &domainExternal{Client: &godo.Client{Domains: &fake.MockDomainsService{
+				MockGet: func(_ context.Context, name string) (*godo.Domain, *godo.Response, error) {
+					if name != domainName {
+						t.Errorf("unexpected domain %s observed", name)
+					}
+					return tc.args.domain, tc.args.getResp, tc.args.getErr
+				},
+				MockRecordsByType: func(_ context.Context, _, recordType string, opt *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+					if recordType != v1alpha1.RecordTypeNS {
+						t.Errorf("unexpected %s records listed", recordType)
+					}
+					if tc.args.nsErr != nil {
+						return nil, nil, tc.args.nsErr
+					}
+					if opt.Page == 1 {
+						next := "https://api.digitalocean.com/v2/domains/example.com/records?page=2"
+						return pages[0], &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Next: next, Last: next}}}, nil
+					}
+					return pages[1], nil, nil
+				},
+			}}}
+			cr := domain()
+			o, err := e.Observe(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_domainExternal_Create(t *testing.T) {
+	type want struct {
+		create *godo.DomainCreateRequest
+		err    error
+	}
+	tests := map[string]struct {
+		p         v1alpha1.DomainParameters
+		createErr error
+		want      want
+	}{
+		"Created": {
+			want: want{
+				create: &godo.DomainCreateRequest{Name: domainName},
+			},
+		},
+		"CreatedWithIPAddress": {
+			p: v1alpha1.DomainParameters{IPAddress: godo.String("192.0.2.1")},
+			want: want{
+				create: &godo.DomainCreateRequest{Name: domainName, IPAddress: "192.0.2.1"},
+			},
+		},
+		"CreateFailed": {
+			createErr: errBoom,
+			want: want{
+				create: &godo.DomainCreateRequest{Name: domainName},
+				err:    errors.Wrap(errBoom, errDomainCreateFailed),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var create *godo.DomainCreateRequest
+			e := &domainExternal{Client: &godo.Client{Domains: &fake.MockDomainsService{
+				MockCreate: func(_ context.Context, r *godo.DomainCreateRequest) (*godo.Domain, *godo.Response, error) {
+					create = r
+					return &godo.Domain{Name: r.Name}, nil, tc.createErr
+				},
+			}}}
+			_, err := e.Create(context.Background(), domain(withDomainSpec(tc.p)))
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_domainExternal_Delete(t *testing.T) {
+	type args struct {
+		deleteErr  error
+		deleteResp *godo.Response
+	}
+	tests := map[string]struct {
+		args args
+		want error
+	}{
+		"Deleted": {
+			args: args{},
+		},
+		"AlreadyGone": {
+			args: args{deleteErr: errBoom, deleteResp: notFound()},
+		},
+		"DeleteFailed": {
+			args: args{deleteErr: errBoom},
+			want: errors.Wrap(errBoom, errDomainDeleteFailed),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &domainExternal{Client: &godo.Client{Domains: &fake.MockDomainsService{
+				MockDelete: func(_ context.Context, name string) (*godo.Response, error) {
+					if name != domainName {
+						t.Errorf("unexpected domain %s deleted", name)
+					}
+					return tc.args.deleteResp, tc.args.deleteErr
+				},
+			}}}
+			err := e.Delete(context.Background(), domain())
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/dns/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+	dodns "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/dns"
+)
+
+const (
+	// Error strings.
+	errNotDomainRecord   = "managed resource is not a DomainRecord resource"
+	errGetDomainRecord   = "cannot get DomainRecord"
+	errParseRecordID     = "cannot parse the ID of the DomainRecord from its external name"
+	errInvalidRecord     = "invalid DomainRecord"
+	errDomainRecordWrite = "cannot update managed DomainRecord resource"
+
+	errDomainRecordCreateFailed = "creation of DomainRecord resource has failed"
+	errDomainRecordUpdateFailed = "update of DomainRecord resource has failed"
+	errDomainRecordDeleteFailed = "deletion of DomainRecord resource has failed"
+)
+
+// SetupDomainRecord adds a controller that reconciles DomainRecord managed
+// resources.
+func SetupDomainRecord(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DomainRecordGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DomainRecord{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DomainRecordGroupVersionKind),
+			managed.WithExternalConnecter(&domainRecordConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type domainRecordConnector struct {
+	kube client.Client
+}
+
+func (c *domainRecordConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &domainRecordExternal{Client: client, kube: c.kube}, nil
+}
+
+type domainRecordExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+func (c *domainRecordExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DomainRecord)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDomainRecord)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errParseRecordID)
+	}
+
+	observed, response, err := c.Domains.Record(ctx, cr.Spec.ForProvider.Domain, id)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(do.IgnoreNotFound(err, response), errGetDomainRecord)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	dodns.DomainRecordLateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errDomainRecordWrite)
+		}
+	}
+
+	cr.Status.AtProvider = dodns.GenerateDomainRecordObservation(*observed)
+
+	// Records are served as soon as they exist.
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: dodns.DomainRecordIsUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (c *domainRecordExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DomainRecord)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDomainRecord)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	if err := dodns.ValidateDomainRecord(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInvalidRecord)
+	}
+
+	record, _, err := c.Domains.CreateRecord(ctx, cr.Spec.ForProvider.Domain, dodns.GenerateDomainRecord(cr.Spec.ForProvider))
+	if err != nil || record == nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errDomainRecordCreateFailed)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(record.ID))
+
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *domainRecordExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DomainRecord)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDomainRecord)
+	}
+
+	if err := dodns.ValidateDomainRecord(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidRecord)
+	}
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errParseRecordID)
+	}
+
+	_, _, err = c.Domains.EditRecord(ctx, cr.Spec.ForProvider.Domain, id, dodns.GenerateDomainRecord(cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errDomainRecordUpdateFailed)
+}
+
+func (c *domainRecordExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DomainRecord)
+	if !ok {
+		return errors.New(errNotDomainRecord)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	if err != nil {
+		return errors.Wrap(err, errParseRecordID)
+	}
+
+	response, err := c.Domains.DeleteRecord(ctx, cr.Spec.ForProvider.Domain, id)
+	return errors.Wrap(do.IgnoreNotFound(err, response), errDomainRecordDeleteFailed)
+}
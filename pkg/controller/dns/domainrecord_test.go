@@ -0,0 +1,410 @@
+package dns
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/dns/v1alpha1"
+	dodns "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/dns"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/dns/fake"
+)
+
+const (
+	recordID         = 1234
+	recordExternalID = "1234"
+)
+
+type recordModifier func(*v1alpha1.DomainRecord)
+
+func withRecordSpec(p v1alpha1.DomainRecordParameters) recordModifier {
+	return func(cr *v1alpha1.DomainRecord) { cr.Spec.ForProvider = p }
+}
+
+func withRecordExternalName(n string) recordModifier {
+	return func(cr *v1alpha1.DomainRecord) { meta.SetExternalName(cr, n) }
+}
+
+func withRecordStatus(o v1alpha1.DomainRecordObservation) recordModifier {
+	return func(cr *v1alpha1.DomainRecord) { cr.Status.AtProvider = o }
+}
+
+func withRecordConditions(c ...xpv1.Condition) recordModifier {
+	return func(cr *v1alpha1.DomainRecord) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func domainRecord(m ...recordModifier) *v1alpha1.DomainRecord {
+	cr := &v1alpha1.DomainRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "www",
+		},
+		Spec: v1alpha1.DomainRecordSpec{
+			ForProvider: v1alpha1.DomainRecordParameters{
+				Domain: domainName,
+				Type:   v1alpha1.RecordTypeCNAME,
+				Name:   "www",
+				Data:   godo.String("example.com."),
+				TTL:    godo.Int(3600),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func Test_domainRecordExternal_Observe(t *testing.T) {
+	_, errInvalidID := strconv.Atoi("www")
+	observed := &godo.DomainRecord{ID: recordID, Type: v1alpha1.RecordTypeCNAME, Name: "www", Data: "@", TTL: 3600}
+	uninitialized := v1alpha1.DomainRecordParameters{Domain: domainName, Type: v1alpha1.RecordTypeCNAME, Name: "www"}
+	initialized := uninitialized
+	initialized.Data = godo.String("@")
+	initialized.TTL = godo.Int(3600)
+	moved := v1alpha1.DomainRecordParameters{Domain: domainName, Type: v1alpha1.RecordTypeCNAME, Name: "www", Data: godo.String("example.org.")}
+	movedInitialized := moved
+	movedInitialized.TTL = godo.Int(3600)
+
+	type args struct {
+		cr        *v1alpha1.DomainRecord
+		getErr    error
+		getResp   *godo.Response
+		updateErr error
+	}
+	type want struct {
+		cr     *v1alpha1.DomainRecord
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"NotCreated": {
+			args: args{
+				cr: domainRecord(),
+			},
+			want: want{
+				cr: domainRecord(),
+			},
+		},
+		"InvalidID": {
+			args: args{
+				cr: domainRecord(withRecordExternalName("www")),
+			},
+			want: want{
+				cr:  domainRecord(withRecordExternalName("www")),
+				err: errors.Wrap(errInvalidID, errParseRecordID),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cr:      domainRecord(withRecordExternalName(recordExternalID)),
+				getErr:  errBoom,
+				getResp: notFound(),
+			},
+			want: want{
+				cr: domainRecord(withRecordExternalName(recordExternalID)),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				cr:     domainRecord(withRecordExternalName(recordExternalID)),
+				getErr: errBoom,
+			},
+			want: want{
+				cr:  domainRecord(withRecordExternalName(recordExternalID)),
+				err: errors.Wrap(errBoom, errGetDomainRecord),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				cr: domainRecord(withRecordExternalName(recordExternalID)),
+			},
+			want: want{
+				cr: domainRecord(withRecordExternalName(recordExternalID),
+					withRecordStatus(dodns.GenerateDomainRecordObservation(*observed)),
+					withRecordConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				cr: domainRecord(withRecordExternalName(recordExternalID), withRecordSpec(uninitialized)),
+			},
+			want: want{
+				cr: domainRecord(withRecordExternalName(recordExternalID), withRecordSpec(initialized),
+					withRecordStatus(dodns.GenerateDomainRecordObservation(*observed)),
+					withRecordConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitializeFailed": {
+			args: args{
+				cr:        domainRecord(withRecordExternalName(recordExternalID), withRecordSpec(uninitialized)),
+				updateErr: errBoom,
+			},
+			want: want{
+				cr:  domainRecord(withRecordExternalName(recordExternalID), withRecordSpec(initialized)),
+				err: errors.Wrap(errBoom, errDomainRecordWrite),
+			},
+		},
+		"OutOfDate": {
+			args: args{
+				cr: domainRecord(withRecordExternalName(recordExternalID), withRecordSpec(moved)),
+			},
+			want: want{
+				cr: domainRecord(withRecordExternalName(recordExternalID), withRecordSpec(movedInitialized),
+					withRecordStatus(dodns.GenerateDomainRecordObservation(*observed)),
+					withRecordConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &domainRecordExternal{
+				kube: &test.MockClient{
+					MockUpdate: func(context.Context, client.Object, ...client.UpdateOption) error {
+						return tc.args.updateErr
+					},
+				},
+				Client: &godo.Client{Domains: &fake.MockDomainsService{
+					MockRecord: func(_ context.Context, domain string, id int) (*godo.DomainRecord, *godo.Response, error) {
+						if domain != domainName || id != recordID {
+							t.Errorf("unexpected record %s/%d observed", domain, id)
+						}
+						if tc.args.getErr != nil {
+							return nil, tc.args.getResp, tc.args.getErr
+						}
+						return observed, nil, nil
+					},
+				}},
+			}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_domainRecordExternal_Create(t *testing.T) {
+	unresolved := v1alpha1.DomainRecordParameters{Domain: domainName, Type: v1alpha1.RecordTypeA, Name: "www"}
+
+	type args struct {
+		cr        *v1alpha1.DomainRecord
+		createErr error
+	}
+	type want struct {
+		cr     *v1alpha1.DomainRecord
+		create *godo.DomainRecordEditRequest
+		result managed.ExternalCreation
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"Created": {
+			args: args{
+				cr: domainRecord(),
+			},
+			want: want{
+				cr:     domainRecord(withRecordExternalName(recordExternalID), withRecordConditions(xpv1.Creating())),
+				create: &godo.DomainRecordEditRequest{Type: v1alpha1.RecordTypeCNAME, Name: "www", Data: "example.com.", TTL: 3600},
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"Invalid": {
+			args: args{
+				cr: domainRecord(withRecordSpec(unresolved)),
+			},
+			want: want{
+				cr:  domainRecord(withRecordSpec(unresolved), withRecordConditions(xpv1.Creating())),
+				err: errors.Wrap(dodns.ValidateDomainRecord(unresolved), errInvalidRecord),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				cr:        domainRecord(),
+				createErr: errBoom,
+			},
+			want: want{
+				cr:     domainRecord(withRecordConditions(xpv1.Creating())),
+				create: &godo.DomainRecordEditRequest{Type: v1alpha1.RecordTypeCNAME, Name: "www", Data: "example.com.", TTL: 3600},
+				err:    errors.Wrap(errBoom, errDomainRecordCreateFailed),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var create *godo.DomainRecordEditRequest
+			e := &domainRecordExternal{Client: &godo.Client{Domains: &fake.MockDomainsService{
+				MockCreateRecord: func(_ context.Context, domain string, r *godo.DomainRecordEditRequest) (*godo.DomainRecord, *godo.Response, error) {
+					if domain != domainName {
+						t.Errorf("unexpected record created in domain %s", domain)
+					}
+					create = r
+					if tc.args.createErr != nil {
+						return nil, nil, tc.args.createErr
+					}
+					return &godo.DomainRecord{ID: recordID}, nil, nil
+				},
+			}}}
+			c, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, c); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_domainRecordExternal_Update(t *testing.T) {
+	_, errInvalidID := strconv.Atoi("www")
+	unresolved := v1alpha1.DomainRecordParameters{Domain: domainName, Type: v1alpha1.RecordTypeA, Name: "www"}
+
+	type args struct {
+		cr      *v1alpha1.DomainRecord
+		editErr error
+	}
+	type want struct {
+		edit *godo.DomainRecordEditRequest
+		err  error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"Updated": {
+			args: args{
+				cr: domainRecord(withRecordExternalName(recordExternalID)),
+			},
+			want: want{
+				edit: &godo.DomainRecordEditRequest{Type: v1alpha1.RecordTypeCNAME, Name: "www", Data: "example.com.", TTL: 3600},
+			},
+		},
+		"Invalid": {
+			args: args{
+				cr: domainRecord(withRecordExternalName(recordExternalID), withRecordSpec(unresolved)),
+			},
+			want: want{
+				err: errors.Wrap(dodns.ValidateDomainRecord(unresolved), errInvalidRecord),
+			},
+		},
+		"InvalidID": {
+			args: args{
+				cr: domainRecord(withRecordExternalName("www")),
+			},
+			want: want{
+				err: errors.Wrap(errInvalidID, errParseRecordID),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				cr:      domainRecord(withRecordExternalName(recordExternalID)),
+				editErr: errBoom,
+			},
+			want: want{
+				edit: &godo.DomainRecordEditRequest{Type: v1alpha1.RecordTypeCNAME, Name: "www", Data: "example.com.", TTL: 3600},
+				err:  errors.Wrap(errBoom, errDomainRecordUpdateFailed),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var edit *godo.DomainRecordEditRequest
+			e := &domainRecordExternal{Client: &godo.Client{Domains: &fake.MockDomainsService{
+				MockEditRecord: func(_ context.Context, domain string, id int, r *godo.DomainRecordEditRequest) (*godo.DomainRecord, *godo.Response, error) {
+					if domain != domainName || id != recordID {
+						t.Errorf("unexpected record %s/%d updated", domain, id)
+					}
+					edit = r
+					return &godo.DomainRecord{ID: id}, nil, tc.args.editErr
+				},
+			}}}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.edit, edit); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_domainRecordExternal_Delete(t *testing.T) {
+	_, errInvalidID := strconv.Atoi("www")
+
+	type args struct {
+		cr         *v1alpha1.DomainRecord
+		deleteErr  error
+		deleteResp *godo.Response
+	}
+	tests := map[string]struct {
+		args args
+		want error
+	}{
+		"Deleted": {
+			args: args{cr: domainRecord(withRecordExternalName(recordExternalID))},
+		},
+		"InvalidID": {
+			args: args{cr: domainRecord(withRecordExternalName("www"))},
+			want: errors.Wrap(errInvalidID, errParseRecordID),
+		},
+		"AlreadyGone": {
+			args: args{cr: domainRecord(withRecordExternalName(recordExternalID)), deleteErr: errBoom, deleteResp: notFound()},
+		},
+		"DeleteFailed": {
+			args: args{cr: domainRecord(withRecordExternalName(recordExternalID)), deleteErr: errBoom},
+			want: errors.Wrap(errBoom, errDomainRecordDeleteFailed),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &domainRecordExternal{Client: &godo.Client{Domains: &fake.MockDomainsService{
+				MockDeleteRecord: func(_ context.Context, domain string, id int) (*godo.Response, error) {
+					if domain != domainName || id != recordID {
+						t.Errorf("unexpected record %s/%d deleted", domain, id)
+					}
+					return tc.args.deleteResp, tc.args.deleteErr
+				},
+			}}}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ package kubernetes
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -25,6 +27,11 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -42,8 +49,28 @@ const (
 	errContainerRegistryCreateFailed = "creation of DOContainerRegistry resource has failed"
 	errContainerRegistryDeleteFailed = "deletion of DOContainerRegistry resource has failed"
 	errContainerRegistryUpdate       = "cannot update managed DOContainerRegistry resource"
+	errGetDockerCredentials          = "cannot get DOContainerRegistry Docker credentials"
+	errGetConnectionSecret           = "cannot get DOContainerRegistry connection secret"
+	errPublishDockerConfig           = "cannot create or update DOContainerRegistry Docker config secret"
+	errDeleteConnectionSecret        = "cannot delete DOContainerRegistry connection secret of another type"
+	errSecretTypeMismatch            = "DOContainerRegistry connection secret %s/%s has type %q instead of %q and is not controlled by the DOContainerRegistry; delete it to publish the Docker config"
+	errInvalidGarbageCollection      = "cannot parse DOContainerRegistry garbage collection schedule"
+	errGetGarbageCollection          = "cannot get DOContainerRegistry garbage collection"
+	errStartGarbageCollection        = "cannot start DOContainerRegistry garbage collection"
+	errInvalidTagRetention           = "cannot parse DOContainerRegistry protected tags"
+	errListRepositories              = "cannot list DOContainerRegistry repositories"
+	errListRepositoryTags            = "cannot list DOContainerRegistry repository tags"
+	errDeleteTag                     = "cannot delete DOContainerRegistry repository tag"
+	errDeleteManifest                = "cannot delete DOContainerRegistry repository manifest"
+	errGetRegistryOptions            = "cannot get DOContainerRegistry options"
+	errTierChangeBlocked             = "DOContainerRegistry subscription tier change is blocked"
 
 	subscriptionOutDated = "subscription is not up to date"
+	garbageCollectionDue = "garbage collection is due"
+	tagsExpired          = "tags are not retained by the tag retention policy"
+
+	// listPageSize is the number of repositories or tags requested per page.
+	listPageSize = 200
 )
 
 // SetupDOContainerRegistry adds a controller that reconciles DOContainerRegistry managed
@@ -58,7 +85,11 @@ func SetupDOContainerRegistry(mgr ctrl.Manager, l logging.Logger) error {
 			resource.ManagedKind(v1alpha1.DOContainerRegistryGroupVersionKind),
 			managed.WithExternalConnecter(&containerRegistryConnector{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithConnectionPublishers(),
+			managed.WithConnectionPublishers(&dockerConfigPublisher{
+				kube:   mgr.GetClient(),
+				secret: resource.NewAPIPatchingApplicator(mgr.GetClient()),
+				typer:  mgr.GetScheme(),
+			}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -116,19 +147,303 @@ func (c *containerRegistryExternal) Observe(ctx context.Context, mg resource.Man
 		}
 	}
 
+	previous := cr.Status.AtProvider
 	cr.Status.AtProvider = dok8s.GenerateContainerRegistryObservation(observed, subscription)
+	cr.Status.AtProvider.RepositoryCount = previous.RepositoryCount
+	cr.Status.AtProvider.RepositoryCountUpdatedAt = previous.RepositoryCountUpdatedAt
+	cr.Status.AtProvider.DockerCredentials = previous.DockerCredentials
+	cr.Status.AtProvider.GarbageCollection = previous.GarbageCollection
+	cr.Status.AtProvider.TagRetention = previous.TagRetention
+
+	if err := c.observeRepositoryCount(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if exceeded := dok8s.TierUsageExceeded(cr.Status.AtProvider.Subscription.Tier, cr.Status.AtProvider.StorageUsageBytes, cr.Status.AtProvider.RepositoryCount); len(exceeded) > 0 {
+		cr.SetConditions(v1alpha1.OverQuota(strings.Join(exceeded, ", ")))
+	} else {
+		cr.SetConditions(v1alpha1.WithinQuota())
+	}
+
+	connection, err := c.observeDockerCredentials(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if err := c.observeGarbageCollection(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if err := c.observeTagRetention(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
 	if cr.Spec.ForProvider.SubscriptionTier != cr.Status.AtProvider.Subscription.Tier.Slug {
 		return managed.ExternalObservation{
-			ResourceExists:   true,
-			ResourceUpToDate: false,
-			Diff:             subscriptionOutDated,
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              subscriptionOutDated,
+			ConnectionDetails: connection,
+		}, nil
+	}
+
+	if dok8s.GarbageCollectionDue(cr.Status.AtProvider.GarbageCollection, time.Now()) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              garbageCollectionDue,
+			ConnectionDetails: connection,
+		}, nil
+	}
+
+	if tagsPendingDeletion(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			Diff:              tagsExpired,
+			ConnectionDetails: connection,
 		}, nil
 	}
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: connection,
+	}, nil
+}
+
+// observeRepositoryCount periodically records the number of repositories of
+// the registry, which requires listing all of them.
+func (c *containerRegistryExternal) observeRepositoryCount(ctx context.Context, cr *v1alpha1.DOContainerRegistry) error {
+	now := time.Now()
+	if !dok8s.RepositoryCountDue(cr.Status.AtProvider.RepositoryCountUpdatedAt, now) {
+		return nil
+	}
+
+	repositories, err := c.listRepositories(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
+	counted := metav1.NewTime(now)
+	cr.Status.AtProvider.RepositoryCount = uint64(len(repositories))
+	cr.Status.AtProvider.RepositoryCountUpdatedAt = &counted
+	return nil
+}
+
+// observeGarbageCollection records the most recent garbage collection of the
+// registry and when the next one is scheduled.
+func (c *containerRegistryExternal) observeGarbageCollection(ctx context.Context, cr *v1alpha1.DOContainerRegistry) error {
+	policy := cr.Spec.ForProvider.GarbageCollection
+	if policy == nil {
+		cr.Status.AtProvider.GarbageCollection = nil
+		return nil
+	}
+
+	if cr.Status.AtProvider.GarbageCollection == nil {
+		cr.Status.AtProvider.GarbageCollection = &v1alpha1.GarbageCollectionObservation{}
+	}
+	o := cr.Status.AtProvider.GarbageCollection
+	if err := dok8s.ScheduleGarbageCollection(*policy, o, time.Now()); err != nil {
+		return errors.Wrap(err, errInvalidGarbageCollection)
+	}
+
+	name := meta.GetExternalName(cr)
+	// DigitalOcean responds with 404 Not Found when no garbage collection is
+	// active.
+	active, response, err := c.client.GetGarbageCollection(ctx, name)
+	if err != nil && do.IgnoreNotFound(err, response) != nil {
+		return errors.Wrap(err, errGetGarbageCollection)
+	}
+	if err == nil && active != nil {
+		o.Latest = dok8s.GenerateGarbageCollectionRun(active)
+		return nil
+	}
+
+	// There is no active garbage collection, so the most recent one is the
+	// first one in the list of past garbage collections.
+	past, _, err := c.client.ListGarbageCollections(ctx, name, &godo.ListOptions{PerPage: 1})
+	if err != nil {
+		return errors.Wrap(err, errGetGarbageCollection)
+	}
+	if len(past) > 0 {
+		o.Latest = dok8s.GenerateGarbageCollectionRun(past[0])
+	}
+	return nil
+}
+
+// observeTagRetention periodically records the tags of the registry that are
+// not retained by its tag retention policy.
+func (c *containerRegistryExternal) observeTagRetention(ctx context.Context, cr *v1alpha1.DOContainerRegistry) error {
+	policy := cr.Spec.ForProvider.TagRetention
+	if policy == nil {
+		cr.Status.AtProvider.TagRetention = nil
+		return nil
+	}
+
+	now := time.Now()
+	if !dok8s.TagRetentionDue(*policy, cr.Status.AtProvider.TagRetention, now) {
+		return nil
+	}
+
+	repositories, err := c.evaluateTagRetention(ctx, meta.GetExternalName(cr), *policy, now)
+	if err != nil {
+		return err
+	}
+
+	if cr.Status.AtProvider.TagRetention == nil {
+		cr.Status.AtProvider.TagRetention = &v1alpha1.TagRetentionObservation{}
+	}
+	o := cr.Status.AtProvider.TagRetention
+	evaluated := metav1.NewTime(now)
+	o.LastEvaluatedAt = &evaluated
+	var expired []string
+	for _, r := range repositories {
+		for _, t := range r.expired {
+			expired = append(expired, r.name+":"+t.Tag)
+		}
+	}
+	dok8s.ObserveExpiredTags(o, expired)
+	return nil
+}
+
+// tagsPendingDeletion returns true if the most recent evaluation of the tag
+// retention policy found tags that should be deleted.
+func tagsPendingDeletion(cr *v1alpha1.DOContainerRegistry) bool {
+	policy := cr.Spec.ForProvider.TagRetention
+	o := cr.Status.AtProvider.TagRetention
+	return policy != nil && !policy.DryRun && o != nil && o.ExpiredTagCount > 0
+}
+
+// listRepositories returns the names of all repositories of the supplied
+// registry.
+func (c *containerRegistryExternal) listRepositories(ctx context.Context, registry string) ([]string, error) {
+	var names []string
+	opts := &godo.TokenListOptions{PerPage: listPageSize}
+	for {
+		page, response, err := c.client.ListRepositoriesV2(ctx, registry, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, errListRepositories)
+		}
+		for _, r := range page {
+			names = append(names, r.Name)
+		}
+		if response == nil || response.Links == nil || response.Links.IsLastPage() {
+			return names, nil
+		}
+		token, err := response.Links.NextPageToken()
+		if err != nil || token == "" {
+			return nil, errors.Wrap(err, errListRepositories)
+		}
+		opts.Token = token
+	}
+}
+
+// repositoryTags are the tags of a single repository of a registry.
+type repositoryTags struct {
+	name    string
+	all     []*godo.RepositoryTag
+	expired []*godo.RepositoryTag
+}
+
+// evaluateTagRetention lists the tags of all repositories of the supplied
+// registry and determines which of them are not retained by the supplied
+// policy.
+func (c *containerRegistryExternal) evaluateTagRetention(ctx context.Context, registry string, p v1alpha1.TagRetentionPolicy, now time.Time) ([]repositoryTags, error) {
+	names, err := c.listRepositories(ctx, registry)
+	if err != nil {
+		return nil, err
+	}
+
+	repositories := make([]repositoryTags, 0, len(names))
+	for _, name := range names {
+		r := repositoryTags{name: name}
+		opts := &godo.ListOptions{Page: 1, PerPage: listPageSize}
+		for {
+			page, response, err := c.client.ListRepositoryTags(ctx, registry, name, opts)
+			if err != nil {
+				return nil, errors.Wrap(err, errListRepositoryTags)
+			}
+			r.all = append(r.all, page...)
+			if response == nil || response.Links == nil || response.Links.IsLastPage() {
+				break
+			}
+			opts.Page++
+		}
+
+		expired, err := dok8s.ExpiredTags(p, r.all, now)
+		if err != nil {
+			return nil, errors.Wrap(err, errInvalidTagRetention)
+		}
+		r.expired = expired
+		repositories = append(repositories, r)
+	}
+	return repositories, nil
+}
+
+// pruneTags deletes the tags of the registry that are not retained by its tag
+// retention policy. Manifests whose tags all expired are deleted as a whole.
+func (c *containerRegistryExternal) pruneTags(ctx context.Context, cr *v1alpha1.DOContainerRegistry) error {
+	registry := meta.GetExternalName(cr)
+	now := time.Now()
+	repositories, err := c.evaluateTagRetention(ctx, registry, *cr.Spec.ForProvider.TagRetention, now)
+	if err != nil {
+		return err
+	}
+
+	pruned := 0
+	for _, r := range repositories {
+		manifests, tags := dok8s.PlanTagDeletions(r.all, r.expired)
+		for _, digest := range manifests {
+			if response, err := c.client.DeleteManifest(ctx, registry, r.name, digest); do.IgnoreNotFound(err, response) != nil {
+				return errors.Wrap(err, errDeleteManifest)
+			}
+		}
+		for _, tag := range tags {
+			if response, err := c.client.DeleteTag(ctx, registry, r.name, tag); do.IgnoreNotFound(err, response) != nil {
+				return errors.Wrap(err, errDeleteTag)
+			}
+		}
+		pruned += len(r.expired)
+	}
+
+	o := cr.Status.AtProvider.TagRetention
+	at := metav1.NewTime(now)
+	o.LastEvaluatedAt = &at
+	o.LastPrunedAt = &at
+	o.LastPrunedCount = pruned
+	dok8s.ObserveExpiredTags(o, nil)
+	return nil
+}
+
+// observeDockerCredentials issues new Docker credentials when the connection
+// secret is missing or the published credentials are due for rotation.
+// Otherwise the credentials already present in the connection secret are kept.
+func (c *containerRegistryExternal) observeDockerCredentials(ctx context.Context, cr *v1alpha1.DOContainerRegistry) (managed.ConnectionDetails, error) {
+	ref := cr.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		cr.Status.AtProvider.DockerCredentials = nil
+		return nil, nil
+	}
+
+	due := dok8s.DockerCredentialsDue(cr.Spec.ForProvider.DockerCredentials, cr.Status.AtProvider.DockerCredentials, time.Now())
+	if !due {
+		err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, &corev1.Secret{})
+		if resource.Ignore(kerrors.IsNotFound, err) != nil {
+			return nil, errors.Wrap(err, errGetConnectionSecret)
+		}
+		due = kerrors.IsNotFound(err)
+	}
+	if !due {
+		return nil, nil
+	}
+
+	credentials, _, err := c.client.DockerCredentials(ctx, dok8s.GenerateDockerCredentialsRequest(cr.Spec.ForProvider.DockerCredentials))
+	if err != nil || credentials == nil {
+		return nil, errors.Wrap(err, errGetDockerCredentials)
+	}
+	cr.Status.AtProvider.DockerCredentials = dok8s.GenerateDockerCredentialsObservation(cr.Spec.ForProvider.DockerCredentials, time.Now())
+
+	return managed.ConnectionDetails{
+		corev1.DockerConfigJsonKey: credentials.DockerConfigJSON,
 	}, nil
 }
 
@@ -167,16 +482,68 @@ func (c *containerRegistryExternal) Update(ctx context.Context, mg resource.Mana
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotContainerRegistry)
 	}
-	update := &godo.RegistrySubscriptionUpdateRequest{TierSlug: cr.Spec.ForProvider.SubscriptionTier}
 
-	containerRegistry, _, err := c.client.UpdateSubscription(ctx, update)
-	if err != nil || containerRegistry == nil {
-		err = errors.Wrap(err, errContainerRegistryUpdate)
+	blocked, err := c.updateSubscription(ctx, cr)
+	if err != nil {
 		cr.Status.SetConditions(xpv1.ReconcileError(err))
 		return managed.ExternalUpdate{}, err
 	}
 
-	return managed.ExternalUpdate{}, nil
+	if policy := cr.Spec.ForProvider.GarbageCollection; policy != nil && dok8s.GarbageCollectionDue(cr.Status.AtProvider.GarbageCollection, time.Now()) {
+		gc, _, err := c.client.StartGarbageCollection(ctx, meta.GetExternalName(cr), dok8s.GenerateStartGarbageCollectionRequest(*policy))
+		if err != nil {
+			err = errors.Wrap(err, errStartGarbageCollection)
+			cr.Status.SetConditions(xpv1.ReconcileError(err))
+			return managed.ExternalUpdate{}, err
+		}
+
+		o := cr.Status.AtProvider.GarbageCollection
+		now := metav1.Now()
+		o.LastScheduledAt = &now
+		o.Latest = dok8s.GenerateGarbageCollectionRun(gc)
+		if err := dok8s.ScheduleGarbageCollection(*policy, o, now.Time); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidGarbageCollection)
+		}
+	}
+
+	if tagsPendingDeletion(cr) {
+		if err := c.pruneTags(ctx, cr); err != nil {
+			cr.Status.SetConditions(xpv1.ReconcileError(err))
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	// A blocked subscription change does not prevent the remaining updates,
+	// but is still reported as an error so that it is retried.
+	return managed.ExternalUpdate{}, blocked
+}
+
+// updateSubscription changes the subscription tier of the registry to the
+// desired one unless the current usage exceeds the limits of the desired tier,
+// in which case the returned blocked error explains why the change is blocked.
+func (c *containerRegistryExternal) updateSubscription(ctx context.Context, cr *v1alpha1.DOContainerRegistry) (blocked error, err error) {
+	slug := cr.Spec.ForProvider.SubscriptionTier
+	if slug == cr.Status.AtProvider.Subscription.Tier.Slug {
+		return nil, nil
+	}
+
+	options, _, err := c.client.GetOptions(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetRegistryOptions)
+	}
+	if err := dok8s.ValidateTierChange(slug, options, cr.Status.AtProvider.StorageUsageBytes, cr.Status.AtProvider.RepositoryCount); err != nil {
+		cr.SetConditions(v1alpha1.TierChangeBlocked(err))
+		return errors.Wrap(err, errTierChangeBlocked), nil
+	}
+
+	subscription, _, err := c.client.UpdateSubscription(ctx, &godo.RegistrySubscriptionUpdateRequest{TierSlug: slug})
+	if err != nil || subscription == nil {
+		return nil, errors.Wrap(err, errContainerRegistryUpdate)
+	}
+	if cr.GetCondition(v1alpha1.TypeTierChangeBlocked).Reason != "" {
+		cr.SetConditions(v1alpha1.TierChangeAllowed())
+	}
+	return nil, nil
 }
 
 func (c *containerRegistryExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -196,3 +563,57 @@ func (c *containerRegistryExternal) Delete(ctx context.Context, mg resource.Mana
 	}
 	return nil
 }
+
+// A dockerConfigPublisher publishes the Docker credentials of a
+// DOContainerRegistry to a Secret of type kubernetes.io/dockerconfigjson, so
+// that the connection secret can be used as an imagePullSecret.
+type dockerConfigPublisher struct {
+	kube   client.Client
+	secret resource.Applicator
+	typer  runtime.ObjectTyper
+}
+
+// PublishConnection publishes the supplied Docker credentials. Connection
+// details without Docker credentials are not published, leaving the
+// credentials that were published before in place.
+func (p *dockerConfigPublisher) PublishConnection(ctx context.Context, mg resource.Managed, c managed.ConnectionDetails) error {
+	if mg.GetWriteConnectionSecretToReference() == nil || len(c[corev1.DockerConfigJsonKey]) == 0 {
+		return nil
+	}
+
+	s := resource.ConnectionSecretFor(mg, resource.MustGetKind(mg, p.typer))
+	s.Type = corev1.SecretTypeDockerConfigJson
+	s.Data = c
+	if err := p.deleteSecretOfOtherType(ctx, mg, s); err != nil {
+		return err
+	}
+	return errors.Wrap(p.secret.Apply(ctx, s, resource.ConnectionSecretMustBeControllableBy(mg.GetUID())), errPublishDockerConfig)
+}
+
+// deleteSecretOfOtherType deletes the existing Secret with the name of the
+// supplied Secret if it is of another type, e.g. an Opaque connection secret
+// published before, because the type of a Secret cannot be changed. Secrets
+// that are not controlled by the supplied managed resource are not deleted.
+func (p *dockerConfigPublisher) deleteSecretOfOtherType(ctx context.Context, mg resource.Managed, s *corev1.Secret) error {
+	current := &corev1.Secret{}
+	err := p.kube.Get(ctx, types.NamespacedName{Namespace: s.GetNamespace(), Name: s.GetName()}, current)
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errGetConnectionSecret)
+	}
+	if current.Type == s.Type {
+		return nil
+	}
+	if c := metav1.GetControllerOf(current); c == nil || c.UID != mg.GetUID() {
+		return errors.Errorf(errSecretTypeMismatch, current.GetNamespace(), current.GetName(), current.Type, s.Type)
+	}
+	return errors.Wrap(resource.IgnoreNotFound(p.kube.Delete(ctx, current)), errDeleteConnectionSecret)
+}
+
+// UnpublishConnection is a no-op since the published Secret is garbage
+// collected by Kubernetes when the managed resource is deleted.
+func (p *dockerConfigPublisher) UnpublishConnection(ctx context.Context, mg resource.Managed, c managed.ConnectionDetails) error {
+	return nil
+}
@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
@@ -9,13 +10,20 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/digitalocean/godo"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -100,6 +108,55 @@ func withStatus(s v1alpha1.DOContainerRegistryObservation) registryModifier {
 	return func(r *v1alpha1.DOContainerRegistry) { r.Status.AtProvider = s }
 }
 
+func withConnectionSecret(ref *xpv1.SecretReference) registryModifier {
+	return func(r *v1alpha1.DOContainerRegistry) { r.Spec.WriteConnectionSecretToReference = ref }
+}
+
+func withDockerCredentials(o *v1alpha1.DockerCredentialsObservation) registryModifier {
+	return func(r *v1alpha1.DOContainerRegistry) { r.Status.AtProvider.DockerCredentials = o }
+}
+
+var limitedSubscription = &godo.RegistrySubscription{
+	Tier: &godo.RegistrySubscriptionTier{
+		Name:                 tier,
+		Slug:                 tier,
+		IncludedRepositories: 1,
+		IncludedStorageBytes: 1024,
+	},
+	CreatedAt: observedSubscription.CreatedAt,
+	UpdatedAt: observedSubscription.UpdatedAt,
+}
+
+func genLimitedContainerRegistryObservation() v1alpha1.DOContainerRegistryObservation {
+	o := genContainerRegistryObservation(tier)
+	o.Subscription.Tier.IncludedRepositories = 1
+	o.Subscription.Tier.IncludedStorageBytes = 1024
+	return o
+}
+
+func withRepositoryCount(o v1alpha1.DOContainerRegistryObservation, count uint64) v1alpha1.DOContainerRegistryObservation {
+	o.RepositoryCount = count
+	return o
+}
+
+func listRepositories(count int) func(context.Context, string, *godo.TokenListOptions) ([]*godo.RepositoryV2, *godo.Response, error) {
+	return func(context.Context, string, *godo.TokenListOptions) ([]*godo.RepositoryV2, *godo.Response, error) {
+		repositories := make([]*godo.RepositoryV2, count)
+		for i := range repositories {
+			repositories[i] = &godo.RepositoryV2{Name: fmt.Sprintf("repository-%d", i)}
+		}
+		return repositories, &godo.Response{}, nil
+	}
+}
+
+func getOptions(context.Context) (*godo.RegistryOptions, *godo.Response, error) {
+	return &godo.RegistryOptions{
+		SubscriptionTiers: []*godo.RegistrySubscriptionTier{
+			{Slug: "basic", IncludedRepositories: 5, IncludedStorageBytes: 5368709120, AllowStorageOverage: true},
+		},
+	}, nil, nil
+}
+
 func registry(m ...registryModifier) *v1alpha1.DOContainerRegistry {
 	cr := &v1alpha1.DOContainerRegistry{
 		ObjectMeta: metav1.ObjectMeta{
@@ -251,6 +308,10 @@ func Test_containerRegistryExternal_Delete(t *testing.T) {
 }
 
 func Test_containerRegistryExternal_Observe(t *testing.T) {
+	countedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	counted := withRepositoryCount(genContainerRegistryObservation(tier), 3)
+	counted.RepositoryCountUpdatedAt = &countedAt
+
 	type want struct {
 		cr     *v1alpha1.DOContainerRegistry
 		result managed.ExternalObservation
@@ -269,6 +330,7 @@ func Test_containerRegistryExternal_Observe(t *testing.T) {
 					MockGetSubscription: func(ctx context.Context) (*godo.RegistrySubscription, *godo.Response, error) {
 						return observedSubscription, nil, nil
 					},
+					MockListRepositoriesV2: listRepositories(1),
 				},
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockClient().Update,
@@ -281,7 +343,7 @@ func Test_containerRegistryExternal_Observe(t *testing.T) {
 				cr: registry(withSpec(v1alpha1.DOContainerRegistryParameters{
 					SubscriptionTier: tier,
 					Region:           godo.String(region),
-				}), withExternalName(name), withConditions(xpv1.Available()), withStatus(genContainerRegistryObservation(tier))),
+				}), withExternalName(name), withConditions(xpv1.Available(), v1alpha1.WithinQuota()), withStatus(withRepositoryCount(genContainerRegistryObservation(tier), 1))),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: true,
@@ -298,6 +360,7 @@ func Test_containerRegistryExternal_Observe(t *testing.T) {
 					MockGetSubscription: func(ctx context.Context) (*godo.RegistrySubscription, *godo.Response, error) {
 						return observedSubscription, nil, nil
 					},
+					MockListRepositoriesV2: listRepositories(1),
 				},
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockClient().Update,
@@ -311,7 +374,7 @@ func Test_containerRegistryExternal_Observe(t *testing.T) {
 				cr: registry(withSpec(v1alpha1.DOContainerRegistryParameters{
 					SubscriptionTier: "basic",
 					Region:           godo.String(region),
-				}), withExternalName(name), withConditions(xpv1.Available()), withStatus(genContainerRegistryObservation(tier))),
+				}), withExternalName(name), withConditions(xpv1.Available(), v1alpha1.WithinQuota()), withStatus(withRepositoryCount(genContainerRegistryObservation(tier), 1))),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: false,
@@ -320,6 +383,65 @@ func Test_containerRegistryExternal_Observe(t *testing.T) {
 				err: nil,
 			},
 		},
+		"OverQuota": {
+			args: args{
+				containerRegistry: &fake.MockRegistryClient{
+					MockGet: func(ctx context.Context) (*godo.Registry, *godo.Response, error) {
+						return observedRegistry, nil, nil
+					},
+					MockGetSubscription: func(ctx context.Context) (*godo.RegistrySubscription, *godo.Response, error) {
+						return limitedSubscription, nil, nil
+					},
+					MockListRepositoriesV2: listRepositories(2),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				cr: registry(withSpec(v1alpha1.DOContainerRegistryParameters{
+					SubscriptionTier: tier,
+					Region:           godo.String(region),
+				}), withExternalName(name), withConditions(xpv1.Available())),
+			},
+			want: want{
+				cr: registry(withSpec(v1alpha1.DOContainerRegistryParameters{
+					SubscriptionTier: tier,
+					Region:           godo.String(region),
+				}), withExternalName(name), withConditions(xpv1.Available(), v1alpha1.OverQuota(`2 repositories exceed the 1 included in subscription tier "stater"`)), withStatus(withRepositoryCount(genLimitedContainerRegistryObservation(), 2))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RepositoriesCountedRecently": {
+			args: args{
+				containerRegistry: &fake.MockRegistryClient{
+					MockGet: func(ctx context.Context) (*godo.Registry, *godo.Response, error) {
+						return observedRegistry, nil, nil
+					},
+					MockGetSubscription: func(ctx context.Context) (*godo.RegistrySubscription, *godo.Response, error) {
+						return observedSubscription, nil, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				cr: registry(withSpec(v1alpha1.DOContainerRegistryParameters{
+					SubscriptionTier: tier,
+					Region:           godo.String(region),
+				}), withExternalName(name), withConditions(xpv1.Available()), withStatus(counted)),
+			},
+			want: want{
+				cr: registry(withSpec(v1alpha1.DOContainerRegistryParameters{
+					SubscriptionTier: tier,
+					Region:           godo.String(region),
+				}), withExternalName(name), withConditions(xpv1.Available(), v1alpha1.WithinQuota()), withStatus(counted)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"GetFailed": {
 			args: args{
 				containerRegistry: &fake.MockRegistryClient{
@@ -333,6 +455,7 @@ func Test_containerRegistryExternal_Observe(t *testing.T) {
 					MockGetSubscription: func(ctx context.Context) (*godo.RegistrySubscription, *godo.Response, error) {
 						return observedSubscription, nil, nil
 					},
+					MockListRepositoriesV2: listRepositories(1),
 				},
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockClient().Update,
@@ -360,7 +483,9 @@ func Test_containerRegistryExternal_Observe(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+			// The time at which the repositories were counted is not known in advance.
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(),
+				cmpopts.IgnoreFields(v1alpha1.DOContainerRegistryObservation{}, "RepositoryCountUpdatedAt")); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
@@ -383,6 +508,7 @@ func Test_containerRegistryExternal_Update(t *testing.T) {
 		"Successful": {
 			args: args{
 				containerRegistry: &fake.MockRegistryClient{
+					MockGetOptions: getOptions,
 					MockUpdateSubscription: func(ctx context.Context, request *godo.RegistrySubscriptionUpdateRequest) (*godo.RegistrySubscription, *godo.Response, error) {
 						return &godo.RegistrySubscription{}, nil, nil
 					},
@@ -401,9 +527,29 @@ func Test_containerRegistryExternal_Update(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"DowngradeBlocked": {
+			args: args{
+				containerRegistry: &fake.MockRegistryClient{
+					MockGetOptions: getOptions,
+				},
+				cr: registry(withSpec(v1alpha1.DOContainerRegistryParameters{
+					SubscriptionTier: "basic",
+					Region:           godo.String(region),
+				}), withExternalName(name), withConditions(xpv1.Available()), withStatus(withRepositoryCount(genContainerRegistryObservation(tier), 6))),
+			},
+			want: want{
+				cr: registry(withSpec(v1alpha1.DOContainerRegistryParameters{
+					SubscriptionTier: "basic",
+					Region:           godo.String(region),
+				}), withExternalName(name), withConditions(xpv1.Available(), v1alpha1.TierChangeBlocked(errors.New(`cannot change to subscription tier "basic": 6 repositories exceed the 5 included in subscription tier "basic"`))), withStatus(withRepositoryCount(genContainerRegistryObservation(tier), 6))),
+				result: managed.ExternalUpdate{},
+				err:    errors.Wrap(errors.New(`cannot change to subscription tier "basic": 6 repositories exceed the 5 included in subscription tier "basic"`), errTierChangeBlocked),
+			},
+		},
 		"UpdateFailed": {
 			args: args{
 				containerRegistry: &fake.MockRegistryClient{
+					MockGetOptions: getOptions,
 					MockUpdateSubscription: func(ctx context.Context, request *godo.RegistrySubscriptionUpdateRequest) (*godo.RegistrySubscription, *godo.Response, error) {
 						return nil, &godo.Response{
 							Response: &http.Response{
@@ -443,3 +589,341 @@ func Test_containerRegistryExternal_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_containerRegistryExternal_observeDockerCredentials(t *testing.T) {
+	issuedAt := metav1.Now()
+	dockerConfig := []byte(`{"auths":{}}`)
+	ref := &xpv1.SecretReference{Name: "registry", Namespace: "crossplane-system"}
+
+	type want struct {
+		result managed.ConnectionDetails
+		issued bool
+		err    error
+	}
+	tests := map[string]struct {
+		args
+		want
+	}{
+		"NoConnectionSecret": {
+			args: args{
+				cr: registry(),
+			},
+			want: want{},
+		},
+		"NotIssued": {
+			args: args{
+				containerRegistry: &fake.MockRegistryClient{
+					MockDockerCredentials: func(context.Context, *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, *godo.Response, error) {
+						return &godo.DockerCredentials{DockerConfigJSON: dockerConfig}, nil, nil
+					},
+				},
+				cr: registry(withConnectionSecret(ref)),
+			},
+			want: want{
+				result: managed.ConnectionDetails{".dockerconfigjson": dockerConfig},
+				issued: true,
+			},
+		},
+		"SecretExists": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: registry(withConnectionSecret(ref), withDockerCredentials(&v1alpha1.DockerCredentialsObservation{IssuedAt: &issuedAt})),
+			},
+			want: want{
+				issued: true,
+			},
+		},
+		"SecretMissing": {
+			args: args{
+				containerRegistry: &fake.MockRegistryClient{
+					MockDockerCredentials: func(context.Context, *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, *godo.Response, error) {
+						return &godo.DockerCredentials{DockerConfigJSON: dockerConfig}, nil, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, ref.Name)),
+				},
+				cr: registry(withConnectionSecret(ref), withDockerCredentials(&v1alpha1.DockerCredentialsObservation{IssuedAt: &issuedAt})),
+			},
+			want: want{
+				result: managed.ConnectionDetails{".dockerconfigjson": dockerConfig},
+				issued: true,
+			},
+		},
+		"IssueFailed": {
+			args: args{
+				containerRegistry: &fake.MockRegistryClient{
+					MockDockerCredentials: func(context.Context, *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, *godo.Response, error) {
+						return nil, nil, errors.New("")
+					},
+				},
+				cr: registry(withConnectionSecret(ref)),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(""), errGetDockerCredentials),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &containerRegistryExternal{kube: tc.kube, client: tc.containerRegistry}
+			o, err := e.observeDockerCredentials(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.issued, tc.args.cr.Status.AtProvider.DockerCredentials != nil); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_containerRegistryExternal_observeGarbageCollection(t *testing.T) {
+	lastScheduled := metav1.NewTime(time.Now().Add(-time.Hour))
+	policy := v1alpha1.DOContainerRegistryParameters{GarbageCollection: &v1alpha1.GarbageCollectionPolicy{Schedule: "0 3 * * 0"}}
+	running := &godo.GarbageCollection{UUID: "gc-2", Status: "requested"}
+	finished := &godo.GarbageCollection{UUID: "gc-1", Status: kubernetes.GarbageCollectionStatusSucceeded, BlobsDeleted: 42, FreedBytes: 1024}
+	withRunning := func() v1alpha1.DOContainerRegistryObservation {
+		return v1alpha1.DOContainerRegistryObservation{GarbageCollection: &v1alpha1.GarbageCollectionObservation{
+			LastScheduledAt: &lastScheduled,
+			Latest:          kubernetes.GenerateGarbageCollectionRun(running),
+		}}
+	}
+
+	type want struct {
+		latest *v1alpha1.GarbageCollectionRun
+		listed bool
+		err    error
+	}
+	tests := map[string]struct {
+		getGC   *godo.GarbageCollection
+		getResp *godo.Response
+		getErr  error
+		past    []*godo.GarbageCollection
+		want    want
+	}{
+		"Active": {
+			getGC: running,
+			want: want{
+				latest: kubernetes.GenerateGarbageCollectionRun(running),
+			},
+		},
+		"NoneActiveFinishedRunListed": {
+			getResp: &godo.Response{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			getErr:  errors.New("garbage collection not found"),
+			past:    []*godo.GarbageCollection{finished},
+			want: want{
+				latest: kubernetes.GenerateGarbageCollectionRun(finished),
+				listed: true,
+			},
+		},
+		"GetFailed": {
+			getResp: &godo.Response{Response: &http.Response{StatusCode: http.StatusInternalServerError}},
+			getErr:  errors.New("boom"),
+			want: want{
+				latest: kubernetes.GenerateGarbageCollectionRun(running),
+				err:    errors.Wrap(errors.New("boom"), errGetGarbageCollection),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			listed := false
+			client := &fake.MockRegistryClient{
+				MockGetGarbageCollection: func(context.Context, string) (*godo.GarbageCollection, *godo.Response, error) {
+					return tc.getGC, tc.getResp, tc.getErr
+				},
+				MockListGarbageCollections: func(context.Context, string, *godo.ListOptions) ([]*godo.GarbageCollection, *godo.Response, error) {
+					listed = true
+					return tc.past, nil, nil
+				},
+			}
+			cr := registry(withExternalName(name), withSpec(policy), withStatus(withRunning()))
+			e := &containerRegistryExternal{client: client}
+			err := e.observeGarbageCollection(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.latest, cr.Status.AtProvider.GarbageCollection.Latest); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.listed, listed); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_containerRegistryExternal_pruneTags(t *testing.T) {
+	keepOne := 1
+	now := time.Now()
+	tags := []*godo.RepositoryTag{
+		{Tag: "new", ManifestDigest: "sha256:new", UpdatedAt: now},
+		{Tag: "old", ManifestDigest: "sha256:old", UpdatedAt: now.Add(-time.Hour)},
+		{Tag: "older", ManifestDigest: "sha256:new", UpdatedAt: now.Add(-2 * time.Hour)},
+	}
+	policy := v1alpha1.DOContainerRegistryParameters{TagRetention: &v1alpha1.TagRetentionPolicy{KeepLast: &keepOne}}
+
+	type want struct {
+		manifests []string
+		tags      []string
+		pruned    int
+		err       error
+	}
+	tests := map[string]struct {
+		args
+		want
+	}{
+		"Pruned": {
+			args: args{
+				cr: registry(withExternalName(name), withSpec(policy), withStatus(v1alpha1.DOContainerRegistryObservation{
+					TagRetention: &v1alpha1.TagRetentionObservation{ExpiredTagCount: 2, ExpiredTags: []string{"app:old", "app:older"}},
+				})),
+			},
+			want: want{
+				manifests: []string{"sha256:old"},
+				tags:      []string{"older"},
+				pruned:    2,
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				cr: registry(withExternalName(name), withSpec(policy), withStatus(v1alpha1.DOContainerRegistryObservation{
+					TagRetention: &v1alpha1.TagRetentionObservation{},
+				})),
+			},
+			want: want{
+				manifests: []string{"sha256:old"},
+				err:       errors.Wrap(errors.New("boom"), errDeleteManifest),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var manifests, deleted []string
+			client := &fake.MockRegistryClient{
+				MockListRepositoriesV2: func(context.Context, string, *godo.TokenListOptions) ([]*godo.RepositoryV2, *godo.Response, error) {
+					return []*godo.RepositoryV2{{Name: "app"}}, &godo.Response{}, nil
+				},
+				MockListRepositoryTags: func(context.Context, string, string, *godo.ListOptions) ([]*godo.RepositoryTag, *godo.Response, error) {
+					return tags, &godo.Response{}, nil
+				},
+				MockDeleteManifest: func(_ context.Context, _, _, digest string) (*godo.Response, error) {
+					manifests = append(manifests, digest)
+					if tc.want.err != nil {
+						return nil, errors.New("boom")
+					}
+					return nil, nil
+				},
+				MockDeleteTag: func(_ context.Context, _, _, tag string) (*godo.Response, error) {
+					deleted = append(deleted, tag)
+					return nil, nil
+				},
+			}
+			e := &containerRegistryExternal{client: client}
+			err := e.pruneTags(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.manifests, manifests); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tags, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pruned, tc.args.cr.Status.AtProvider.TagRetention.LastPrunedCount); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_dockerConfigPublisher_PublishConnection(t *testing.T) {
+	uid := types.UID("registry-uid")
+	ref := &xpv1.SecretReference{Namespace: "default", Name: "registry-creds"}
+	details := managed.ConnectionDetails{corev1.DockerConfigJsonKey: []byte("{}")}
+	withSecret := func(secretType corev1.SecretType, controller types.UID) test.ObjectFn {
+		return func(obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.SetNamespace(ref.Namespace)
+			s.SetName(ref.Name)
+			s.Type = secretType
+			if controller != "" {
+				c := true
+				s.SetOwnerReferences([]metav1.OwnerReference{{UID: controller, Controller: &c}})
+			}
+			return nil
+		}
+	}
+	notFound := kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, ref.Name)
+
+	type want struct {
+		deleted bool
+		applied bool
+		err     error
+	}
+	tests := map[string]struct {
+		get  test.MockGetFn
+		want want
+	}{
+		"NoSecret": {
+			get:  test.NewMockGetFn(notFound),
+			want: want{applied: true},
+		},
+		"SameType": {
+			get:  test.NewMockGetFn(nil, withSecret(corev1.SecretTypeDockerConfigJson, uid)),
+			want: want{applied: true},
+		},
+		"OpaqueSecretControlled": {
+			get:  test.NewMockGetFn(nil, withSecret(corev1.SecretTypeOpaque, uid)),
+			want: want{deleted: true, applied: true},
+		},
+		"OpaqueSecretNotControlled": {
+			get: test.NewMockGetFn(nil, withSecret(corev1.SecretTypeOpaque, "")),
+			want: want{
+				err: errors.Errorf(errSecretTypeMismatch, ref.Namespace, ref.Name, corev1.SecretTypeOpaque, corev1.SecretTypeDockerConfigJson),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			got := want{}
+			p := &dockerConfigPublisher{
+				kube: &test.MockClient{
+					MockGet: tc.get,
+					MockDelete: func(context.Context, client.Object, ...client.DeleteOption) error {
+						got.deleted = true
+						return nil
+					},
+				},
+				secret: resource.ApplyFn(func(_ context.Context, o client.Object, _ ...resource.ApplyOption) error {
+					if o.(*corev1.Secret).Type != corev1.SecretTypeDockerConfigJson {
+						t.Errorf("published secret of type %q", o.(*corev1.Secret).Type)
+					}
+					got.applied = true
+					return nil
+				}),
+				typer: s,
+			}
+			cr := registry(withConnectionSecret(ref))
+			cr.SetUID(uid)
+			got.err = p.PublishConnection(context.Background(), cr, details)
+
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -15,10 +15,12 @@ package kubernetes
 
 import (
 	"context"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -44,6 +46,15 @@ const (
 	errK8sDeleteFailed = "deletion of DOKubernetesCluster resource has failed"
 	errK8sUpdate       = "cannot update managed DOKubernetesCluster resource"
 	errFetchingConfig  = "fetching of DOKubernetesCluster Kubeconfig has failed"
+
+	errGetUpgrades        = "cannot get available upgrades of DOKubernetesCluster"
+	errRunClusterlint     = "cannot run clusterlint on DOKubernetesCluster"
+	errGetClusterlintDiag = "cannot get clusterlint diagnostics of DOKubernetesCluster"
+	errRecycleNode        = "cannot recycle node of DOKubernetesCluster"
+	errGetOptions         = "cannot get available options of DOKubernetesCluster"
+	errInvalidParameters  = "parameters of DOKubernetesCluster are invalid"
+
+	nodesPendingRecycle = "nodes are pending recycle"
 )
 
 // SetupKubernetesCluster adds a controller that reconciles DOKubernetesCluster managed
@@ -89,6 +100,9 @@ func (c *k8sExternal) Observe(ctx context.Context, mg resource.Managed) (managed
 	}
 
 	if meta.GetExternalName(cr) == "" {
+		if _, err := c.validateParameters(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, nil
@@ -107,14 +121,27 @@ func (c *k8sExternal) Observe(ctx context.Context, mg resource.Managed) (managed
 		}
 	}
 
+	lint := cr.Status.AtProvider.Clusterlint
+	pools := cr.Status.AtProvider.NodePools
 	cr.Status.AtProvider = dok8s.GenerateObservation(observed)
+	cr.Status.AtProvider.Clusterlint = lint
+	dok8s.ObserveNodePoolRecycling(cr.Spec.ForProvider.NodePools, pools, observed, cr.Status.AtProvider.NodePools, time.Now())
 	dok8s.SetCondition(cr)
 
+	if err := c.observeClusterlint(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	extObs := managed.ExternalObservation{
 		ResourceExists:   true,
 		ResourceUpToDate: true,
 	}
 
+	if dok8s.NodePoolsRecyclePending(cr.Status.AtProvider.NodePools) {
+		extObs.ResourceUpToDate = false
+		extObs.Diff = nodesPendingRecycle
+	}
+
 	if cr.Spec.WriteConnectionSecretToReference != nil {
 		config, resp, err := c.Kubernetes.GetKubeConfig(ctx, observed.ID)
 
@@ -130,6 +157,82 @@ func (c *k8sExternal) Observe(ctx context.Context, mg resource.Managed) (managed
 	return extObs, nil
 }
 
+// observeClusterlint requests a clusterlint run when one is due and collects
+// the diagnostics of a pending run once they are available.
+func (c *k8sExternal) observeClusterlint(ctx context.Context, cr *v1alpha1.DOKubernetesCluster) error {
+	policy := cr.Spec.ForProvider.Clusterlint
+	if policy == nil || !policy.Enabled {
+		cr.Status.AtProvider.Clusterlint = nil
+		if cr.GetCondition(v1alpha1.TypeLintWarnings).Reason != "" {
+			cr.SetConditions(v1alpha1.LintDisabled())
+		}
+		return nil
+	}
+
+	// Clusterlint inspects the workloads of the cluster, so it can only run
+	// once the cluster is up.
+	if cr.Status.AtProvider.Status.State != v1alpha1.KubernetesStateRunning {
+		return nil
+	}
+
+	id := cr.Status.AtProvider.ID
+	upgrades, _, err := c.Kubernetes.GetUpgrades(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, errGetUpgrades)
+	}
+	upgradeVersion := dok8s.LatestUpgradeVersion(upgrades)
+
+	if dok8s.ClusterlintDue(*policy, cr.Status.AtProvider.Clusterlint, upgradeVersion, time.Now()) {
+		runID, _, err := c.Kubernetes.RunClusterlint(ctx, id, dok8s.GenerateClusterlintRequest(*policy))
+		if err != nil {
+			return errors.Wrap(err, errRunClusterlint)
+		}
+		now := metav1.Now()
+		cr.Status.AtProvider.Clusterlint = &v1alpha1.KubernetesClusterlintObservation{
+			RunID:          runID,
+			RequestedAt:    &now,
+			UpgradeVersion: upgradeVersion,
+		}
+		// The diagnostics of a run are not available right away. They are
+		// collected on a later observation.
+		return nil
+	}
+
+	lint := cr.Status.AtProvider.Clusterlint
+	if lint.CompletedAt != nil {
+		return nil
+	}
+
+	diagnostics, response, err := c.Kubernetes.GetClusterlintResults(ctx, id, &godo.KubernetesGetClusterlintRequest{RunId: lint.RunID})
+	if err != nil {
+		// A run that has not finished yet is reported as not found.
+		return errors.Wrap(do.IgnoreNotFound(err, response), errGetClusterlintDiag)
+	}
+
+	now := metav1.Now()
+	lint.CompletedAt = &now
+	lint.Diagnostics = dok8s.GenerateClusterlintDiagnostics(diagnostics)
+	if count := dok8s.CountClusterlintErrors(lint.Diagnostics); count > 0 {
+		cr.SetConditions(v1alpha1.LintErrors(count))
+	} else {
+		cr.SetConditions(v1alpha1.LintClean())
+	}
+	return nil
+}
+
+// validateParameters validates the parameters of the supplied cluster against
+// the options offered by DigitalOcean, records the outcome in the conditions
+// of the cluster and returns the version slug it should be created with.
+func (c *k8sExternal) validateParameters(ctx context.Context, cr *v1alpha1.DOKubernetesCluster) (string, error) {
+	options, _, err := c.Kubernetes.GetOptions(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, errGetOptions)
+	}
+
+	version, err := dok8s.ValidateParameters(cr.Spec.ForProvider, options)
+	return version, do.RecordValidation(cr, err, errInvalidParameters)
+}
+
 func (c *k8sExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.DOKubernetesCluster)
 	if !ok {
@@ -150,7 +253,13 @@ func (c *k8sExternal) Create(ctx context.Context, mg resource.Managed) (managed.
 		return managed.ExternalCreation{}, errors.New(errK8sNameRequired)
 	}
 
+	version, err := c.validateParameters(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	dok8s.GenerateKubernetes(name, cr.Spec.ForProvider, create)
+	create.VersionSlug = version
 
 	k8s, _, err := c.Kubernetes.Create(ctx, create)
 	if err != nil || k8s == nil {
@@ -163,7 +272,26 @@ func (c *k8sExternal) Create(ctx context.Context, mg resource.Managed) (managed.
 }
 
 func (c *k8sExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	// Droplets cannot be updated.
+	cr, ok := mg.(*v1alpha1.DOKubernetesCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotK8s)
+	}
+
+	// Nodes are replaced one at a time per node pool. The next node is only
+	// deleted once the previous one is gone and its replacement is up and
+	// running.
+	for i, pool := range cr.Status.AtProvider.NodePools {
+		nodeID := dok8s.NextNodeToRecycle(pool)
+		if nodeID == "" {
+			continue
+		}
+		response, err := c.Kubernetes.DeleteNode(ctx, cr.Status.AtProvider.ID, pool.ID, nodeID, &godo.KubernetesNodeDeleteRequest{Replace: true})
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(do.IgnoreNotFound(err, response), errRecycleNode)
+		}
+		cr.Status.AtProvider.NodePools[i].RecyclingNodeID = nodeID
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -12,3 +12,186 @@ limitations under the License.
 */
 
 package kubernetes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/kubernetes/v1alpha1"
+	dov1alpha1 "github.com/crossplane-contrib/provider-digitalocean/apis/v1alpha1"
+	dok8s "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/kubernetes"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/kubernetes/fake"
+)
+
+type clusterModifier func(*v1alpha1.DOKubernetesCluster)
+
+func withNodePools(p ...v1alpha1.KubernetesNodePoolObservation) clusterModifier {
+	return func(c *v1alpha1.DOKubernetesCluster) { c.Status.AtProvider.NodePools = p }
+}
+
+func withClusterSpec(p v1alpha1.DOKubernetesClusterParameters) clusterModifier {
+	return func(c *v1alpha1.DOKubernetesCluster) { c.Spec.ForProvider = p }
+}
+
+func withClusterConditions(c ...xpv1.Condition) clusterModifier {
+	return func(cr *v1alpha1.DOKubernetesCluster) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func cluster(m ...clusterModifier) *v1alpha1.DOKubernetesCluster {
+	cr := &v1alpha1.DOKubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	cr.Status.AtProvider.ID = "cluster"
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func Test_k8sExternal_ObserveNotCreated(t *testing.T) {
+	options := &godo.KubernetesOptions{
+		Versions: []*godo.KubernetesVersion{{Slug: "1.22.2-do.0", KubernetesVersion: "1.22.2"}},
+		Regions:  []*godo.KubernetesRegion{{Slug: "nyc1"}},
+		Sizes:    []*godo.KubernetesNodeSize{{Slug: "s-1vcpu-2gb"}},
+	}
+	valid := v1alpha1.DOKubernetesClusterParameters{
+		Region:    "nyc1",
+		Version:   "latest",
+		NodePools: []v1alpha1.KubernetesNodePool{{Name: "pool", Size: "s-1vcpu-2gb"}},
+	}
+	invalid := valid
+	invalid.Region = "mars1"
+	_, invalidErr := dok8s.ValidateParameters(invalid, options)
+
+	type want struct {
+		cr     *v1alpha1.DOKubernetesCluster
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		cr   *v1alpha1.DOKubernetesCluster
+		want want
+	}{
+		"Valid": {
+			cr: cluster(withClusterSpec(valid)),
+			want: want{
+				cr: cluster(withClusterSpec(valid)),
+			},
+		},
+		"Invalid": {
+			cr: cluster(withClusterSpec(invalid)),
+			want: want{
+				cr:  cluster(withClusterSpec(invalid), withClusterConditions(dov1alpha1.ParametersRejected(invalidErr))),
+				err: errors.Wrap(invalidErr, errInvalidParameters),
+			},
+		},
+		"Corrected": {
+			cr: cluster(withClusterSpec(valid), withClusterConditions(dov1alpha1.ParametersRejected(invalidErr))),
+			want: want{
+				cr: cluster(withClusterSpec(valid), withClusterConditions(dov1alpha1.ParametersAccepted())),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &k8sExternal{Client: &godo.Client{Kubernetes: &fake.MockKubernetesService{
+				MockGetOptions: func(context.Context) (*godo.KubernetesOptions, *godo.Response, error) {
+					return options, nil, nil
+				},
+			}}}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_k8sExternal_Update(t *testing.T) {
+	running := v1alpha1.KubernetesStatus{State: v1alpha1.KubernetesStateRunning}
+	pending := v1alpha1.KubernetesNodePoolObservation{
+		ID:    "pool",
+		Count: 2,
+		Nodes: []v1alpha1.KubernetesNode{{ID: "a", Status: running}, {ID: "b", Status: running, PendingRecycle: true}},
+	}
+	inFlight := pending
+	inFlight.RecyclingNodeID = "b"
+
+	type want struct {
+		cr      *v1alpha1.DOKubernetesCluster
+		deleted []string
+		err     error
+	}
+	tests := map[string]struct {
+		cr         *v1alpha1.DOKubernetesCluster
+		deleteNode error
+		want       want
+	}{
+		"RecycleNode": {
+			cr: cluster(withNodePools(pending)),
+			want: want{
+				cr:      cluster(withNodePools(inFlight)),
+				deleted: []string{"b"},
+			},
+		},
+		"RecycleInFlight": {
+			cr: cluster(withNodePools(inFlight)),
+			want: want{
+				cr: cluster(withNodePools(inFlight)),
+			},
+		},
+		"RecycleFailed": {
+			cr:         cluster(withNodePools(pending)),
+			deleteNode: errors.New("boom"),
+			want: want{
+				cr:      cluster(withNodePools(pending)),
+				deleted: []string{"b"},
+				err:     errors.Wrap(errors.New("boom"), errRecycleNode),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var deleted []string
+			e := &k8sExternal{Client: &godo.Client{Kubernetes: &fake.MockKubernetesService{
+				MockDeleteNode: func(_ context.Context, _, _, nodeID string, req *godo.KubernetesNodeDeleteRequest) (*godo.Response, error) {
+					if !req.Replace {
+						t.Errorf("node %s deleted without replacement", nodeID)
+					}
+					deleted = append(deleted, nodeID)
+					return &godo.Response{Response: &http.Response{StatusCode: http.StatusNoContent}}, tc.deleteNode
+				},
+			}}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
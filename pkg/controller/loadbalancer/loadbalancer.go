@@ -112,6 +112,7 @@ func (c *lbExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 	cr.Status.AtProvider = v1alpha1.LBObservation{
 		CreationTimestamp: observed.Created,
 		ID:                observed.ID,
+		IP:                observed.IP,
 		Status:            observed.Status,
 	}
 
@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/vpc/v1alpha1"
+	do "github.com/crossplane-contrib/provider-digitalocean/pkg/clients"
+	dovpc "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/vpc"
+)
+
+const (
+	// Error strings.
+	errNotVPC = "managed resource is not a VPC resource"
+	errGetVPC = "cannot get VPC"
+
+	errVPCCreateFailed = "creation of VPC resource has failed"
+	errVPCDeleteFailed = "deletion of VPC resource has failed"
+	errVPCUpdate       = "cannot update managed VPC resource"
+	errVPCUpdateFailed = "update of VPC resource has failed"
+	errListVPCMembers  = "cannot list members of VPC"
+	errVPCHasMembers   = "cannot delete VPC that still has members"
+
+	// membersPageSize is the number of members listed when deleting a VPC.
+	// Only the first page is reported in the DeletionBlocked condition.
+	membersPageSize = 20
+)
+
+// SetupVPC adds a controller that reconciles VPC managed resources.
+func SetupVPC(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.VPCGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.VPC{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VPCGroupVersionKind),
+			managed.WithExternalConnecter(&vpcConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type vpcConnector struct {
+	kube client.Client
+}
+
+func (c *vpcConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	token, err := do.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	client := godo.NewFromToken(token)
+	godo.SetUserAgent("crossplane")(client) //nolint:errcheck
+	return &vpcExternal{Client: client, kube: c.kube}, nil
+}
+
+type vpcExternal struct {
+	kube client.Client
+	*godo.Client
+}
+
+func (c *vpcExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.VPC)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotVPC)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, response, err := c.VPCs.Get(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(do.IgnoreNotFound(err, response), errGetVPC)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	dovpc.VPCLateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errVPCUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = dovpc.GenerateVPCObservation(*observed)
+
+	// VPCs are usable as soon as they exist.
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(dovpc.GenerateVPCUpdate(cr.Spec.ForProvider, cr.Status.AtProvider)) == 0,
+	}, nil
+}
+
+func (c *vpcExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.VPC)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotVPC)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	vpc, _, err := c.VPCs.Create(ctx, dovpc.GenerateVPC(cr.GetName(), cr.Spec.ForProvider))
+	if err != nil || vpc == nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errVPCCreateFailed)
+	}
+
+	meta.SetExternalName(cr, vpc.ID)
+
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (c *vpcExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.VPC)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotVPC)
+	}
+
+	fields := dovpc.GenerateVPCUpdate(cr.Spec.ForProvider, cr.Status.AtProvider)
+	if len(fields) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, _, err := c.VPCs.Set(ctx, meta.GetExternalName(cr), fields...)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errVPCUpdateFailed)
+}
+
+func (c *vpcExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.VPC)
+	if !ok {
+		return errors.New(errNotVPC)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// DigitalOcean refuses to delete VPCs that still have members, so report
+	// them instead of retrying the deletion until they are gone.
+	members, response, err := c.VPCs.ListMembers(ctx, meta.GetExternalName(cr), nil, &godo.ListOptions{Page: 1, PerPage: membersPageSize})
+	if err != nil {
+		return errors.Wrap(do.IgnoreNotFound(err, response), errListVPCMembers)
+	}
+	if len(members) > 0 {
+		total := len(members)
+		if response != nil && response.Meta != nil {
+			total = response.Meta.Total
+		}
+		cr.SetConditions(v1alpha1.HasMembers(dovpc.MembersMessage(members, total)))
+		return errors.New(errVPCHasMembers)
+	}
+
+	response, err = c.VPCs.Delete(ctx, meta.GetExternalName(cr))
+	return errors.Wrap(do.IgnoreNotFound(err, response), errVPCDeleteFailed)
+}
@@ -0,0 +1,384 @@
+package vpc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-digitalocean/apis/vpc/v1alpha1"
+	dovpc "github.com/crossplane-contrib/provider-digitalocean/pkg/clients/vpc"
+	"github.com/crossplane-contrib/provider-digitalocean/pkg/clients/vpc/fake"
+)
+
+const (
+	vpcName = "example"
+	vpcID   = "5a4981aa-9653-4bd1-bef5-d6bff52042e4"
+	region  = "nyc3"
+)
+
+var errBoom = errors.New("boom")
+
+func notFound() *godo.Response {
+	return &godo.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+}
+
+type vpcModifier func(*v1alpha1.VPC)
+
+func withVPCSpec(p v1alpha1.VPCParameters) vpcModifier {
+	return func(cr *v1alpha1.VPC) { cr.Spec.ForProvider = p }
+}
+
+func withVPCExternalName(n string) vpcModifier {
+	return func(cr *v1alpha1.VPC) { meta.SetExternalName(cr, n) }
+}
+
+func withVPCStatus(o v1alpha1.VPCObservation) vpcModifier {
+	return func(cr *v1alpha1.VPC) { cr.Status.AtProvider = o }
+}
+
+func withVPCConditions(c ...xpv1.Condition) vpcModifier {
+	return func(cr *v1alpha1.VPC) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func vpc(m ...vpcModifier) *v1alpha1.VPC {
+	cr := &v1alpha1.VPC{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: vpcName,
+		},
+		Spec: v1alpha1.VPCSpec{
+			ForProvider: v1alpha1.VPCParameters{Region: region},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func Test_vpcExternal_Observe(t *testing.T) {
+	observed := &godo.VPC{ID: vpcID, Name: vpcName, RegionSlug: region, IPRange: "10.10.10.0/24", Description: "web"}
+	initialized := v1alpha1.VPCParameters{
+		Name:        godo.String(vpcName),
+		Region:      region,
+		IPRange:     godo.String("10.10.10.0/24"),
+		Description: godo.String("web"),
+	}
+	described := initialized
+	described.Description = godo.String("api")
+
+	type args struct {
+		cr        *v1alpha1.VPC
+		getErr    error
+		getResp   *godo.Response
+		updateErr error
+	}
+	type want struct {
+		cr     *v1alpha1.VPC
+		result managed.ExternalObservation
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"NotCreated": {
+			args: args{
+				cr: vpc(),
+			},
+			want: want{
+				cr: vpc(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cr:      vpc(withVPCExternalName(vpcID)),
+				getErr:  errBoom,
+				getResp: notFound(),
+			},
+			want: want{
+				cr: vpc(withVPCExternalName(vpcID)),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				cr:     vpc(withVPCExternalName(vpcID)),
+				getErr: errBoom,
+			},
+			want: want{
+				cr:  vpc(withVPCExternalName(vpcID)),
+				err: errors.Wrap(errBoom, errGetVPC),
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				cr: vpc(withVPCExternalName(vpcID)),
+			},
+			want: want{
+				cr: vpc(withVPCExternalName(vpcID), withVPCSpec(initialized),
+					withVPCStatus(dovpc.GenerateVPCObservation(*observed)),
+					withVPCConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitializeFailed": {
+			args: args{
+				cr:        vpc(withVPCExternalName(vpcID)),
+				updateErr: errBoom,
+			},
+			want: want{
+				cr:  vpc(withVPCExternalName(vpcID), withVPCSpec(initialized)),
+				err: errors.Wrap(errBoom, errVPCUpdate),
+			},
+		},
+		"OutOfDate": {
+			args: args{
+				cr: vpc(withVPCExternalName(vpcID), withVPCSpec(described)),
+			},
+			want: want{
+				cr: vpc(withVPCExternalName(vpcID), withVPCSpec(described),
+					withVPCStatus(dovpc.GenerateVPCObservation(*observed)),
+					withVPCConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &vpcExternal{
+				kube: &test.MockClient{
+					MockUpdate: func(context.Context, client.Object, ...client.UpdateOption) error {
+						return tc.args.updateErr
+					},
+				},
+				Client: &godo.Client{VPCs: &fake.MockVPCsService{
+					MockGet: func(_ context.Context, id string) (*godo.VPC, *godo.Response, error) {
+						if tc.args.getErr != nil {
+							return nil, tc.args.getResp, tc.args.getErr
+						}
+						return observed, nil, nil
+					},
+				}},
+			}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_vpcExternal_Create(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPC
+		result managed.ExternalCreation
+		err    error
+	}
+	tests := map[string]struct {
+		createErr error
+		want      want
+	}{
+		"Created": {
+			want: want{
+				cr:     vpc(withVPCExternalName(vpcID), withVPCConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			createErr: errBoom,
+			want: want{
+				cr:  vpc(withVPCConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errVPCCreateFailed),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &vpcExternal{Client: &godo.Client{VPCs: &fake.MockVPCsService{
+				MockCreate: func(_ context.Context, create *godo.VPCCreateRequest) (*godo.VPC, *godo.Response, error) {
+					if diff := cmp.Diff(&godo.VPCCreateRequest{Name: vpcName, RegionSlug: region}, create); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					if tc.createErr != nil {
+						return nil, nil, tc.createErr
+					}
+					return &godo.VPC{ID: vpcID}, nil, nil
+				},
+			}}}
+			cr := vpc()
+			c, err := e.Create(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, c); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_vpcExternal_Update(t *testing.T) {
+	observed := v1alpha1.VPCObservation{ID: vpcID, Name: vpcName, Region: region, Description: "web"}
+
+	type args struct {
+		p      v1alpha1.VPCParameters
+		setErr error
+	}
+	type want struct {
+		fields []godo.VPCSetField
+		err    error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				p: v1alpha1.VPCParameters{Region: region, Description: godo.String("web")},
+			},
+		},
+		"Updated": {
+			args: args{
+				p: v1alpha1.VPCParameters{Region: region, Name: godo.String("renamed"), Default: godo.Bool(true)},
+			},
+			want: want{
+				fields: []godo.VPCSetField{godo.VPCSetName("renamed"), godo.VPCSetDefault()},
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				p:      v1alpha1.VPCParameters{Region: region, Description: godo.String("api")},
+				setErr: errBoom,
+			},
+			want: want{
+				fields: []godo.VPCSetField{godo.VPCSetDescription("api")},
+				err:    errors.Wrap(errBoom, errVPCUpdateFailed),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var fields []godo.VPCSetField
+			e := &vpcExternal{Client: &godo.Client{VPCs: &fake.MockVPCsService{
+				MockSet: func(_ context.Context, id string, f ...godo.VPCSetField) (*godo.VPC, *godo.Response, error) {
+					if id != vpcID {
+						t.Errorf("unexpected VPC %s updated", id)
+					}
+					fields = f
+					return &godo.VPC{ID: id}, nil, tc.args.setErr
+				},
+			}}}
+			_, err := e.Update(context.Background(), vpc(withVPCExternalName(vpcID), withVPCSpec(tc.args.p), withVPCStatus(observed)))
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.fields, fields); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_vpcExternal_Delete(t *testing.T) {
+	members := []*godo.VPCMember{{Name: "web-1"}, {URN: "do:kubernetes:k8s-1"}}
+
+	type args struct {
+		members    []*godo.VPCMember
+		total      int
+		listErr    error
+		listResp   *godo.Response
+		deleteErr  error
+		deleteResp *godo.Response
+	}
+	type want struct {
+		cr  *v1alpha1.VPC
+		err error
+	}
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"Deleted": {
+			want: want{
+				cr: vpc(withVPCExternalName(vpcID), withVPCConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{listErr: errBoom, listResp: notFound()},
+			want: want{
+				cr: vpc(withVPCExternalName(vpcID), withVPCConditions(xpv1.Deleting())),
+			},
+		},
+		"ListMembersFailed": {
+			args: args{listErr: errBoom},
+			want: want{
+				cr:  vpc(withVPCExternalName(vpcID), withVPCConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errListVPCMembers),
+			},
+		},
+		"HasMembers": {
+			args: args{members: members, total: 25},
+			want: want{
+				cr: vpc(withVPCExternalName(vpcID), withVPCConditions(xpv1.Deleting(),
+					v1alpha1.HasMembers(dovpc.MembersMessage(members, 25)))),
+				err: errors.New(errVPCHasMembers),
+			},
+		},
+		"DeleteFailed": {
+			args: args{deleteErr: errBoom},
+			want: want{
+				cr:  vpc(withVPCExternalName(vpcID), withVPCConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errVPCDeleteFailed),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := &vpcExternal{Client: &godo.Client{VPCs: &fake.MockVPCsService{
+				MockListMembers: func(context.Context, string, *godo.VPCListMembersRequest, *godo.ListOptions) ([]*godo.VPCMember, *godo.Response, error) {
+					if tc.args.listErr != nil {
+						return nil, tc.args.listResp, tc.args.listErr
+					}
+					return tc.args.members, &godo.Response{Meta: &godo.Meta{Total: tc.args.total}}, nil
+				},
+				MockDelete: func(_ context.Context, id string) (*godo.Response, error) {
+					if id != vpcID {
+						t.Errorf("unexpected VPC %s deleted", id)
+					}
+					return tc.args.deleteResp, tc.args.deleteErr
+				},
+			}}}
+			cr := vpc(withVPCExternalName(vpcID))
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}